@@ -10,6 +10,8 @@
 package must3
 
 import (
+	"fmt"
+
 	"github.com/yyle88/must/internal/utils"
 	"github.com/yyle88/zaplog"
 	"go.uber.org/zap"
@@ -27,15 +29,18 @@ func True(v bool) {
 // Done 使用 Skip3 栈帧调整验证没有错误。如果错误非 nil 则触发 panic。
 func Done(err error) {
 	if err != nil {
-		zaplog.ZAPS.Skip3.LOG.Panic("EXPECTED NO ERROR(BUT HAS ERROR)", zap.Error(err))
+		zaplog.ZAPS.Skip3.LOG.Panic("EXPECTED NO ERROR(BUT HAS ERROR)", zap.Error(err), utils.OriginStack(err))
 	}
 }
 
-// Nice validates non-zero value with Skip3 stack frame adjustment. Returns value if non-zero, panics if zero.
-// Nice 使用 Skip3 栈帧调整验证非零值。如果非零则返回值，如果为零则触发 panic。
+// Nice validates non-zero value with Skip3 stack frame adjustment. Returns value if non-zero, panics if zero or a typed nil.
+// Nice 使用 Skip3 栈帧调整验证非零值。如果非零则返回值，如果为零或为类型化 nil 则触发 panic。
 func Nice[V comparable](a V) V {
 	if a == utils.Zero[V]() {
-		zaplog.ZAPS.Skip3.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", zap.Any("a", a))
+		zaplog.ZAPS.Skip3.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", utils.Any("a", a))
+	}
+	if utils.IsTypedNil(a) {
+		zaplog.ZAPS.Skip3.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", a)))
 	}
 	return a
 }
@@ -44,6 +49,6 @@ func Nice[V comparable](a V) V {
 // Same 使用 Skip3 栈帧调整验证值相等。如果不相等则触发 panic。
 func Same[V comparable](a, b V) {
 	if a != b {
-		zaplog.ZAPS.Skip3.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", zap.Any("a", a), zap.Any("b", b))
+		zaplog.ZAPS.Skip3.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", utils.Any("a", a), utils.Any("b", b))
 	}
 }
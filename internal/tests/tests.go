@@ -0,0 +1,32 @@
+// Package tests provides support functions for the tests of the must assertion packages
+// Implements replacing the zaplog package logs for the duration of a test, to check what failures log
+// Only imported by test files, keeping testing and zaptest out of the assertion packages
+//
+// tests 为 must 断言包的测试提供辅助函数
+// 实现在测试期间替换 zaplog 包级日志，以检查失败时记录的内容
+// 只被测试文件导入，使 testing 和 zaptest 不进入断言包
+package tests
+
+import (
+	"testing"
+
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// ObserveLogs replaces the zaplog package logs with an observer until the test ends, returning the observed logs
+// ObserveLogs 用观察者替换 zaplog 包级日志直到测试结束，返回观察到的日志
+func ObserveLogs(tb testing.TB, opts ...zap.Option) *observer.ObservedLogs {
+	core, logs := observer.New(zap.DebugLevel)
+	ReplaceLog(tb, zap.New(core, opts...))
+	return logs
+}
+
+// ReplaceLog replaces the zaplog package logs with the logger until the test ends
+// ReplaceLog 用给定的 logger 替换 zaplog 包级日志直到测试结束
+func ReplaceLog(tb testing.TB, logger *zap.Logger) {
+	previous := zaplog.LOGGER.LOG
+	zaplog.SetLog(logger)
+	tb.Cleanup(func() { zaplog.SetLog(previous) })
+}
@@ -0,0 +1,18 @@
+package utils
+
+import "go.uber.org/zap"
+
+// Concealer is implemented by values whose content must never be logged, such as mustsecret.Secret
+// Concealer 由内容绝不能被记录的值实现，例如 mustsecret.Secret
+type Concealer interface {
+	Conceal() string // Conceal returns the text logged in place of the content // Conceal 返回代替内容记录的文本
+}
+
+// Any builds a zap field like zap.Any, logging the concealed text when the value implements Concealer.
+// Any 像 zap.Any 一样构建 zap 字段，当值实现 Concealer 时记录隐藏后的文本。
+func Any(key string, v any) zap.Field {
+	if c, ok := v.(Concealer); ok {
+		return zap.String(key, c.Conceal())
+	}
+	return zap.Any(key, v)
+}
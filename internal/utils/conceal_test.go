@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type concealed string
+
+func (concealed) Conceal() string { return "[REDACTED]" }
+
+// TestAny tests building zap fields with Concealer detection
+// Validates concealed values log the concealed text and other values fall back to zap.Any
+//
+// TestAny 测试带 Concealer 检测的 zap 字段构建
+// 验证隐藏值记录隐藏文本，其他值回退到 zap.Any
+func TestAny(t *testing.T) {
+	require.Equal(t, zap.String("a", "[REDACTED]"), Any("a", concealed("abc")))
+	require.Equal(t, zap.Any("a", 1), Any("a", 1))
+	require.Equal(t, zap.Any("a", nil), Any("a", nil))
+}
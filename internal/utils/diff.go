@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+// DiffLimit limits the count of differences logged when a document comparison fails
+// DiffLimit 限制文档比较失败时记录的差异数量
+const DiffLimit = 20
+
+// diffValueWidth is the count of bytes kept when rendering a value in the diff
+// diffValueWidth 是在差异中渲染值时保留的字节数
+const diffValueWidth = 80
+
+// DocumentDiff compares the decoded documents (maps, slices and scalars), returning a line for each difference starting with its path like "$.items[0].id".
+// Map keys of different types stay distinct, so the key 1 does not match the key "1". The sameScalar function compares the other values.
+//
+// DocumentDiff 比较解码后的文档（map、切片和标量），为每处差异返回一行，以其路径开头，例如 "$.items[0].id"。
+// 不同类型的 map 键保持区分，因此键 1 与键 "1" 不相等。sameScalar 函数比较其余的值。
+func DocumentDiff(a, b any, sameScalar func(a, b any) bool) []string {
+	return documentDiff("$", a, b, sameScalar, nil)
+}
+
+// DiffFields returns the count of the differences and the first DiffLimit of them as log fields
+// DiffFields 以日志字段返回差异数量以及前 DiffLimit 条差异
+func DiffFields(diffs []string) []zap.Field {
+	count := len(diffs)
+	if count > DiffLimit {
+		diffs = diffs[:DiffLimit]
+	}
+	return []zap.Field{zap.Int("count", count), zap.Strings("diffs", diffs)}
+}
+
+// documentDiff appends a line for each difference between a and b, each line starting with the path
+// documentDiff 为 a 和 b 之间的每处差异追加一行，每行以路径开头
+func documentDiff(path string, a, b any, sameScalar func(a, b any) bool, diffs []string) []string {
+	if ma, ok := mapping(a); ok {
+		mb, ok := mapping(b)
+		if !ok {
+			return append(diffs, path+": "+renderValue(a)+" != "+renderValue(b))
+		}
+		keys := make([]any, 0, len(ma)+len(mb))
+		for key := range ma {
+			keys = append(keys, key)
+		}
+		for key := range mb {
+			if _, exists := ma[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return JoinKey("", keys[i]) < JoinKey("", keys[j])
+		})
+		for _, key := range keys {
+			subA, inA := ma[key]
+			subB, inB := mb[key]
+			switch {
+			case !inB:
+				diffs = append(diffs, JoinKey(path, key)+": only in a: "+renderValue(subA))
+			case !inA:
+				diffs = append(diffs, JoinKey(path, key)+": only in b: "+renderValue(subB))
+			default:
+				diffs = documentDiff(JoinKey(path, key), subA, subB, sameScalar, diffs)
+			}
+		}
+		return diffs
+	}
+	if va, ok := a.([]any); ok {
+		vb, ok := b.([]any)
+		if !ok {
+			return append(diffs, path+": "+renderValue(a)+" != "+renderValue(b))
+		}
+		if len(va) != len(vb) {
+			diffs = append(diffs, fmt.Sprintf("%s: length %d != %d", path, len(va), len(vb)))
+		}
+		for idx := 0; idx < max(len(va), len(vb)); idx++ {
+			switch {
+			case idx >= len(vb):
+				diffs = append(diffs, JoinIndex(path, idx)+": only in a: "+renderValue(va[idx]))
+			case idx >= len(va):
+				diffs = append(diffs, JoinIndex(path, idx)+": only in b: "+renderValue(vb[idx]))
+			default:
+				diffs = documentDiff(JoinIndex(path, idx), va[idx], vb[idx], sameScalar, diffs)
+			}
+		}
+		return diffs
+	}
+	if _, ok := mapping(b); ok || isSlice(b) || !sameScalar(a, b) {
+		return append(diffs, path+": "+renderValue(a)+" != "+renderValue(b))
+	}
+	return diffs
+}
+
+// mapping returns the entries of a decoded map, keeping the type of each key
+// mapping 返回解码后 map 的条目，保留每个键的类型
+func mapping(v any) (map[any]any, bool) {
+	switch node := v.(type) {
+	case map[string]any:
+		res := make(map[any]any, len(node))
+		for key, sub := range node {
+			res[key] = sub
+		}
+		return res, true
+	case map[any]any:
+		return node, true
+	default:
+		return nil, false
+	}
+}
+
+// isSlice reports whether v is a decoded sequence
+// isSlice 判断 v 是否为解码后的序列
+func isSlice(v any) bool {
+	_, ok := v.([]any)
+	return ok
+}
+
+// renderValue encodes the value into compact JSON-style text, truncated to diffValueWidth bytes
+// renderValue 将值编码为紧凑的 JSON 风格文本，截断到 diffValueWidth 字节
+func renderValue(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		data = []byte(fmt.Sprintf("%v", v))
+	}
+	if len(data) > diffValueWidth {
+		return string(data[:diffValueWidth]) + "..."
+	}
+	return string(data)
+}
+
+// JoinKey appends the key to the path, using ["key"] when the key is not a plain identifier, and [key] when the key is not a string
+// JoinKey 将键追加到路径，当键不是普通标识符时使用 ["key"] 形式，当键不是字符串时使用 [key] 形式
+func JoinKey(path string, key any) string {
+	name, ok := key.(string)
+	if !ok {
+		return path + "[" + renderValue(key) + "]"
+	}
+	if isIdentifier(name) {
+		return path + "." + name
+	}
+	return path + "[" + strconv.Quote(name) + "]"
+}
+
+// JoinIndex appends the index to the path, like "$.items[0]"
+// JoinIndex 将索引追加到路径，例如 "$.items[0]"
+func JoinIndex(path string, idx int) string {
+	return path + "[" + strconv.Itoa(idx) + "]"
+}
+
+// isIdentifier reports whether the key can be written after a dot in a path
+// isIdentifier 判断键是否可以在路径中写在点号之后
+func isIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+	for idx, c := range key {
+		if c == '_' || c == '-' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (idx > 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDocumentDiff tests comparing decoded documents
+// Validates differences are listed with their paths, and keys of different types stay distinct
+//
+// TestDocumentDiff 测试比较解码后的文档
+// 验证差异连同其路径一起列出，且不同类型的键保持区分
+func TestDocumentDiff(t *testing.T) {
+	same := func(a, b any) bool { return a == b }
+
+	a := map[string]any{"a": 1, "b": []any{1, 2}, "c": map[string]any{"d": "x"}}
+	b := map[string]any{"a": 1, "b": []any{1}, "c": []any{"x"}, "e": true}
+	require.Equal(t, []string{
+		`$.b: length 2 != 1`,
+		`$.b[1]: only in a: 2`,
+		`$.c: {"d":"x"} != ["x"]`,
+		`$.e: only in b: true`,
+	}, DocumentDiff(a, b, same))
+
+	require.Equal(t, []string{
+		`$["1"]: only in b: "x"`,
+		`$[1]: only in a: "x"`,
+	}, DocumentDiff(map[any]any{1: "x"}, map[string]any{"1": "x"}, same))
+
+	require.Equal(t, []string{`$: 1 != {"a":1}`}, DocumentDiff(1, map[string]any{"a": 1}, same))
+	require.Nil(t, DocumentDiff(map[any]any{"a": 1}, map[string]any{"a": 1}, same))
+}
+
+// TestDiffFields tests limiting the logged differences
+// Validates the count covers each difference while at most DiffLimit are listed
+//
+// TestDiffFields 测试限制记录的差异
+// 验证数量涵盖所有差异，而最多只列出 DiffLimit 条
+func TestDiffFields(t *testing.T) {
+	diffs := make([]string, DiffLimit+5)
+	fields := DiffFields(diffs)
+	require.Equal(t, int64(DiffLimit+5), fields[0].Integer)
+	require.Len(t, fields[1].Interface, DiffLimit)
+}
+
+// TestJoinKey tests appending keys to a path
+// Validates identifiers use the dot form, other strings the quoted form and other types the bare form
+//
+// TestJoinKey 测试将键追加到路径
+// 验证标识符使用点号形式，其它字符串使用引号形式，其它类型使用裸值形式
+func TestJoinKey(t *testing.T) {
+	require.Equal(t, "$.content-type", JoinKey("$", "content-type"))
+	require.Equal(t, `$["a.b"]`, JoinKey("$", "a.b"))
+	require.Equal(t, `$["0a"]`, JoinKey("$", "0a"))
+	require.Equal(t, `$[""]`, JoinKey("$", ""))
+	require.Equal(t, `$[1]`, JoinKey("$", 1))
+	require.Equal(t, `$[true]`, JoinKey("$", true))
+	require.Equal(t, `$.items[0]`, JoinIndex("$.items", 0))
+}
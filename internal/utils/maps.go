@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"cmp"
+	"slices"
+)
+
+// MissingKeys returns the indexes of the keys not present in the map.
+// MissingKeys 返回 map 中不存在的键的索引。
+func MissingKeys[K comparable, V any](a map[K]V, keys []K) []int {
+	var res []int
+	for idx, key := range keys {
+		if _, exists := a[key]; !exists {
+			res = append(res, idx)
+		}
+	}
+	return res
+}
+
+// UnexpectedKeys returns the keys of the map that are not among the given keys.
+// UnexpectedKeys 返回 map 中不在给定键之列的键。
+func UnexpectedKeys[K comparable, V any](a map[K]V, keys []K) []K {
+	expected := make(map[K]bool, len(keys))
+	for _, key := range keys {
+		expected[key] = true
+	}
+	var res []K
+	for key := range a {
+		if !expected[key] {
+			res = append(res, key)
+		}
+	}
+	return res
+}
+
+// SubMapDiff returns the keys of sub missing from the map, and the keys whose values differ.
+// SubMapDiff 返回 sub 中在 map 里缺失的键，以及值不同的键。
+func SubMapDiff[K, V comparable](sub, a map[K]V) (missing []K, mismatch []K) {
+	for key, value := range sub {
+		got, exists := a[key]
+		if !exists {
+			missing = append(missing, key)
+		} else if got != value {
+			mismatch = append(mismatch, key)
+		}
+	}
+	return missing, mismatch
+}
+
+// UnexpectedValueKeys returns the keys of the map holding values that are not among the given values.
+// UnexpectedValueKeys 返回 map 中持有不在给定值之列的值的键。
+func UnexpectedValueKeys[K, V comparable](a map[K]V, values []V) []K {
+	allowed := make(map[V]bool, len(values))
+	for _, value := range values {
+		allowed[value] = true
+	}
+	var res []K
+	for key, value := range a {
+		if !allowed[value] {
+			res = append(res, key)
+		}
+	}
+	return res
+}
+
+// SortedKeys returns the keys of the map in sorted order.
+// SortedKeys 返回排序后的 map 键。
+func SortedKeys[K cmp.Ordered, V any](a map[K]V) []K {
+	keys := make([]K, 0, len(a))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMissingKeys tests locating the keys not in the map
+// Validates MissingKeys returns the indexes of the missing keys in order
+//
+// TestMissingKeys 测试定位不在 map 中的键
+// 验证 MissingKeys 按顺序返回缺失键的索引
+func TestMissingKeys(t *testing.T) {
+	a := map[string]int{"a": 1, "b": 2}
+	require.Equal(t, []int{1}, MissingKeys(a, []string{"a", "c", "b"}))
+	require.Nil(t, MissingKeys(a, []string{"b"}))
+}
+
+// TestUnexpectedKeys tests locating the map keys not among the given keys
+// Validates UnexpectedKeys returns the keys of the map that were not listed
+//
+// TestUnexpectedKeys 测试定位不在给定键之列的 map 键
+// 验证 UnexpectedKeys 返回 map 中未列出的键
+func TestUnexpectedKeys(t *testing.T) {
+	a := map[string]int{"a": 1, "b": 2, "c": 3}
+	res := UnexpectedKeys(a, []string{"a"})
+	slices.Sort(res)
+	require.Equal(t, []string{"b", "c"}, res)
+	require.Nil(t, UnexpectedKeys(a, []string{"a", "b", "c", "d"}))
+}
+
+// TestSubMapDiff tests comparing a sub map with a map
+// Validates SubMapDiff separates the missing keys from the keys with different values
+//
+// TestSubMapDiff 测试比较子 map 与 map
+// 验证 SubMapDiff 区分缺失的键与值不同的键
+func TestSubMapDiff(t *testing.T) {
+	missing, mismatch := SubMapDiff(map[string]int{"a": 1, "b": 5, "c": 3}, map[string]int{"a": 1, "b": 2})
+	require.Equal(t, []string{"c"}, missing)
+	require.Equal(t, []string{"b"}, mismatch)
+
+	missing, mismatch = SubMapDiff(map[string]int{}, map[string]int{"a": 1})
+	require.Nil(t, missing)
+	require.Nil(t, mismatch)
+}
+
+// TestUnexpectedValueKeys tests locating the map keys holding values not among the given values
+// Validates UnexpectedValueKeys returns the keys whose values are not allowed
+//
+// TestUnexpectedValueKeys 测试定位持有不在给定值之列的值的 map 键
+// 验证 UnexpectedValueKeys 返回值不被允许的键
+func TestUnexpectedValueKeys(t *testing.T) {
+	a := map[string]string{"x": "on", "y": "off", "z": "maybe"}
+	require.Equal(t, []string{"z"}, UnexpectedValueKeys(a, []string{"on", "off"}))
+	require.Nil(t, UnexpectedValueKeys(a, []string{"on", "off", "maybe"}))
+}
+
+// TestSortedKeys tests listing the map keys in order
+// Validates SortedKeys returns the keys sorted, and an empty slice for an empty map
+//
+// TestSortedKeys 测试按顺序列出 map 键
+// 验证 SortedKeys 返回排序后的键，空 map 返回空切片
+func TestSortedKeys(t *testing.T) {
+	require.Equal(t, []string{"a", "b", "c"}, SortedKeys(map[string]any{"c": 3, "a": 1, "b": 2}))
+	require.Equal(t, []int{-1, 2, 10}, SortedKeys(map[int]bool{10: true, -1: false, 2: true}))
+	require.Empty(t, SortedKeys(map[string]int{}))
+}
@@ -0,0 +1,105 @@
+package utils
+
+// DuplicateIndexes returns the index of the first repetition of each duplicate element, so each duplicate appears once.
+// DuplicateIndexes 返回每个重复元素首次重复出现的索引，使每个重复元素只出现一次。
+func DuplicateIndexes[T comparable](a []T) []int {
+	counts := make(map[T]int, len(a))
+	var res []int
+	for idx, v := range a {
+		counts[v]++
+		if counts[v] == 2 {
+			res = append(res, idx)
+		}
+	}
+	return res
+}
+
+// Counts returns the count of occurrences of each element.
+// Counts 返回每个元素的出现次数。
+func Counts[T comparable](a []T) map[T]int {
+	res := make(map[T]int, len(a))
+	for _, v := range a {
+		res[v]++
+	}
+	return res
+}
+
+// ElementsDiff compares a and b as multisets, returning the indexes of elements in a but not in b, and of elements in b but not in a.
+// ElementsDiff 将 a 和 b 作为多重集合比较，返回在 a 中但不在 b 中的元素索引，以及在 b 中但不在 a 中的元素索引。
+func ElementsDiff[T comparable](a, b []T) (missing []int, extra []int) {
+	counts := Counts(a)
+	for _, v := range b {
+		counts[v]--
+	}
+	for idx, v := range a {
+		if counts[v] > 0 {
+			missing = append(missing, idx)
+			counts[v]--
+		}
+	}
+	for idx, v := range b {
+		if counts[v] < 0 {
+			extra = append(extra, idx)
+			counts[v]++
+		}
+	}
+	return missing, extra
+}
+
+// DifferenceIndexes returns the indexes of the distinct elements of a that are not in b.
+// DifferenceIndexes 返回 a 中不在 b 里的去重元素的索引。
+func DifferenceIndexes[T comparable](a, b []T) []int {
+	set := make(map[T]bool, len(b))
+	for _, v := range b {
+		set[v] = true
+	}
+	var res []int
+	for idx, v := range a {
+		if !set[v] {
+			res = append(res, idx)
+			set[v] = true
+		}
+	}
+	return res
+}
+
+// CommonIndexes returns the indexes of the distinct elements of a that are also in b.
+// CommonIndexes 返回 a 中同时在 b 里的去重元素的索引。
+func CommonIndexes[T comparable](a, b []T) []int {
+	set := make(map[T]bool, len(b))
+	for _, v := range b {
+		set[v] = true
+	}
+	var res []int
+	for idx, v := range a {
+		if set[v] {
+			res = append(res, idx)
+			delete(set, v)
+		}
+	}
+	return res
+}
+
+// UnsortedIndex returns the index of the first element ordered before its predecessor by compare, or -1 when sorted.
+// UnsortedIndex 返回第一个按 compare 排在前一个元素之前的元素索引，已排序时返回 -1。
+func UnsortedIndex[T any](a []T, compare func(x, y T) int) int {
+	for idx := 1; idx < len(a); idx++ {
+		if compare(a[idx-1], a[idx]) > 0 {
+			return idx
+		}
+	}
+	return -1
+}
+
+// Pick returns the elements at the indexes.
+// Pick 返回指定索引处的元素。
+func Pick[T any](a []T, indexes []int) []T {
+	if len(indexes) == 0 {
+		return nil
+	}
+	res := make([]T, 0, len(indexes))
+	for _, idx := range indexes {
+		res = append(res, a[idx])
+	}
+	return res
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDuplicateIndexes tests locating the duplicates of a slice
+// Validates each duplicate is reported once, at the index of its first repetition
+//
+// TestDuplicateIndexes 测试定位切片中的重复元素
+// 验证每个重复元素只报告一次，位于其首次重复出现的索引
+func TestDuplicateIndexes(t *testing.T) {
+	require.Equal(t, []int{2, 5}, DuplicateIndexes([]string{"a", "b", "a", "c", "a", "b"}))
+	require.Nil(t, DuplicateIndexes([]int{1, 2, 3}))
+}
+
+// TestElementsDiff tests multiset comparison of two slices
+// Validates surplus occurrences are reported as indexes in a and in b
+//
+// TestElementsDiff 测试两个切片的多重集合比较
+// 验证多出的出现次数以 a 和 b 中的索引报告
+func TestElementsDiff(t *testing.T) {
+	missing, extra := ElementsDiff([]int{1, 2, 2, 3}, []int{3, 2, 1, 2})
+	require.Nil(t, missing)
+	require.Nil(t, extra)
+
+	missing, extra = ElementsDiff([]int{1, 2, 2, 4}, []int{1, 2, 3})
+	require.Equal(t, []int{1, 3}, missing)
+	require.Equal(t, []int{2}, extra)
+}
+
+// TestDifferenceIndexes tests locating the distinct elements of a not in b
+// Validates repeated missing elements are reported once
+//
+// TestDifferenceIndexes 测试定位 a 中不在 b 里的去重元素
+// 验证重复的缺失元素只报告一次
+func TestDifferenceIndexes(t *testing.T) {
+	require.Equal(t, []int{1, 3}, DifferenceIndexes([]string{"a", "x", "x", "y"}, []string{"a", "b"}))
+	require.Nil(t, DifferenceIndexes([]string{"a"}, []string{"a", "b"}))
+}
+
+// TestCommonIndexes tests locating the distinct elements of a also in b
+// Validates repeated common elements are reported once
+//
+// TestCommonIndexes 测试定位 a 中同时在 b 里的去重元素
+// 验证重复的共同元素只报告一次
+func TestCommonIndexes(t *testing.T) {
+	require.Equal(t, []int{0, 2}, CommonIndexes([]int{1, 2, 3, 1}, []int{3, 1}))
+	require.Nil(t, CommonIndexes([]int{1, 2}, []int{3}))
+}
+
+// TestUnsortedIndex tests locating the first out-of-order element
+// Validates UnsortedIndex returns the index of the element ordered before its predecessor, or -1
+//
+// TestUnsortedIndex 测试定位第一个乱序的元素
+// 验证 UnsortedIndex 返回排在前一个元素之前的元素索引，或 -1
+func TestUnsortedIndex(t *testing.T) {
+	require.Equal(t, -1, UnsortedIndex([]int{1, 2, 2, 3}, cmp.Compare[int]))
+	require.Equal(t, -1, UnsortedIndex([]int{}, cmp.Compare[int]))
+	require.Equal(t, 2, UnsortedIndex([]int{1, 3, 2, 0}, cmp.Compare[int]))
+}
+
+// TestPick tests picking the elements at the indexes
+// Validates Pick keeps the order of the indexes and returns nil without indexes
+//
+// TestPick 测试选取指定索引处的元素
+// 验证 Pick 保持索引的顺序，没有索引时返回 nil
+func TestPick(t *testing.T) {
+	require.Equal(t, []string{"c", "a"}, Pick([]string{"a", "b", "c"}, []int{2, 0}))
+	require.Nil(t, Pick([]string{"a"}, nil))
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// stackFrameLimit is the count of frames kept when rendering a stack
+// stackFrameLimit 是渲染堆栈时保留的帧数
+const stackFrameLimit = 32
+
+// ChainDepthLimit stops walking error chains that are too deep or cyclic
+// ChainDepthLimit 用于停止遍历过深或成环的错误链
+const ChainDepthLimit = 32
+
+// StackTracer is implemented by pkg/errors errors carrying a stack trace
+// StackTracer 由携带堆栈的 pkg/errors 错误实现
+type StackTracer interface {
+	StackTrace() errors.StackTrace
+}
+
+// CallersTracer is implemented by errors carrying raw program counters, like go-errors and palantir errors
+// CallersTracer 由携带原始程序计数器的错误实现，例如 go-errors 和 palantir 的错误
+type CallersTracer interface {
+	Callers() []uintptr
+}
+
+// StackFrames renders the stack carried by the error itself (not its wrapped errors), one "function file:line" per frame.
+// Returns nil when the error carries no stack.
+//
+// StackFrames 渲染错误自身（不含被包装的错误）携带的堆栈，每帧一行 "function file:line"。
+// 当错误不携带堆栈时返回 nil。
+func StackFrames(err error) []string {
+	var pcs []uintptr
+	switch tracer := err.(type) {
+	case StackTracer:
+		for _, frame := range tracer.StackTrace() {
+			pcs = append(pcs, uintptr(frame))
+		}
+	case CallersTracer:
+		pcs = tracer.Callers()
+	}
+	if len(pcs) == 0 {
+		return nil
+	}
+	var res []string
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		res = append(res, fmt.Sprintf("%s %s:%d", frame.Function, frame.File, frame.Line))
+		if !more || len(res) >= stackFrameLimit {
+			break
+		}
+	}
+	return res
+}
+
+// OriginStack builds a zap field logging the stack of the deepest error in the chain that carries one, i.e. where the error originated.
+// Walks Unwrap() error, Unwrap() []error (errors.Join) and Cause() error. Returns zap.Skip when no error in the chain carries a stack.
+//
+// OriginStack 构建一个 zap 字段，记录错误链中最深的携带堆栈的错误的堆栈，即错误产生的位置。
+// 遍历 Unwrap() error、Unwrap() []error（errors.Join）和 Cause() error。当链中没有错误携带堆栈时返回 zap.Skip。
+func OriginStack(err error) zap.Field {
+	origin, _ := deepestStack(err, 0)
+	if origin == nil {
+		return zap.Skip()
+	}
+	return zap.Object("error_origin", stackOrigin{kind: fmt.Sprintf("%T", origin), frames: StackFrames(origin)})
+}
+
+// Children returns the errors wrapped by err, through Unwrap() []error (errors.Join), Unwrap() error or Cause() error.
+// The entries may be nil, like the Unwrap result of an error wrapping nothing.
+//
+// Children 返回 err 包装的错误，通过 Unwrap() []error（errors.Join）、Unwrap() error 或 Cause() error 获取。
+// 其中的元素可能为 nil，例如未包装任何错误的 Unwrap 结果。
+func Children(err error) []error {
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() []error }:
+		return wrapped.Unwrap()
+	case interface{ Unwrap() error }:
+		return []error{wrapped.Unwrap()}
+	case interface{ Cause() error }:
+		return []error{wrapped.Cause()}
+	default:
+		return nil
+	}
+}
+
+// deepestStack returns the deepest error carrying a stack and its depth, the first one wins when depths tie
+// deepestStack 返回最深的携带堆栈的错误及其深度，深度相同时取第一个
+func deepestStack(err error, depth int) (error, int) {
+	if err == nil || depth >= ChainDepthLimit {
+		return nil, -1
+	}
+	var origin error
+	originDepth := -1
+	if StackFrames(err) != nil {
+		origin, originDepth = err, depth
+	}
+	for _, child := range Children(err) {
+		if sub, subDepth := deepestStack(child, depth+1); subDepth > originDepth {
+			origin, originDepth = sub, subDepth
+		}
+	}
+	return origin, originDepth
+}
+
+// stackOrigin renders the origin error type and its stack frames as a zap object
+// stackOrigin 将产生错误的类型及其堆栈帧渲染为 zap 对象
+type stackOrigin struct {
+	kind   string
+	frames []string
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler
+// MarshalLogObject 实现 zapcore.ObjectMarshaler
+func (origin stackOrigin) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("type", origin.kind)
+	return enc.AddArray("stack", zapcore.ArrayMarshalerFunc(func(arr zapcore.ArrayEncoder) error {
+		for _, frame := range origin.frames {
+			arr.AppendString(frame)
+		}
+		return nil
+	}))
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	stderrors "errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// callersError carries raw program counters like go-errors and palantir errors
+// callersError 像 go-errors 和 palantir 的错误一样携带原始程序计数器
+type callersError struct {
+	pcs []uintptr
+}
+
+func (e *callersError) Error() string { return "callers error" }
+
+func (e *callersError) Callers() []uintptr { return e.pcs }
+
+func newCallersError() error {
+	pcs := make([]uintptr, 16)
+	return &callersError{pcs: pcs[:runtime.Callers(1, pcs)]}
+}
+
+func newOriginError() error {
+	return errors.New("origin")
+}
+
+// TestStackFrames tests stack rendering of a single error
+// Validates StackFrames renders pkg/errors and Callers stacks, and returns nil when the error carries none
+//
+// TestStackFrames 测试单个错误的堆栈渲染
+// 验证 StackFrames 渲染 pkg/errors 和 Callers 堆栈，在错误不携带堆栈时返回 nil
+func TestStackFrames(t *testing.T) {
+	frames := StackFrames(newOriginError())
+	require.NotEmpty(t, frames)
+	require.Contains(t, frames[0], "utils.newOriginError ")
+	require.Contains(t, frames[0], "stack_test.go:")
+
+	frames = StackFrames(newCallersError())
+	require.NotEmpty(t, frames)
+	require.Contains(t, frames[0], "utils.newCallersError ")
+
+	require.Nil(t, StackFrames(stderrors.New("plain")))
+	require.Nil(t, StackFrames(errors.WithMessage(stderrors.New("plain"), "wrap"))) // only checks the error itself
+}
+
+// TestOriginStack tests origin stack detection across the error chain
+// Validates OriginStack picks the deepest error carrying a stack, walking wrappers and errors.Join branches
+//
+// TestOriginStack 测试跨错误链的产生位置堆栈检测
+// 验证 OriginStack 遍历包装层和 errors.Join 分支，选取最深的携带堆栈的错误
+func TestOriginStack(t *testing.T) {
+	originOf := func(err error) map[string]interface{} {
+		enc := zapcore.NewMapObjectEncoder()
+		OriginStack(err).AddTo(enc)
+		res, _ := enc.Fields["error_origin"].(map[string]interface{})
+		return res
+	}
+
+	origin := originOf(errors.Wrap(fmt.Errorf("ctx: %w", newOriginError()), "load"))
+	require.Equal(t, "*errors.fundamental", origin["type"])
+	require.Contains(t, origin["stack"].([]interface{})[0], "utils.newOriginError ")
+
+	origin = originOf(stderrors.Join(stderrors.New("plain"), fmt.Errorf("ctx: %w", newCallersError())))
+	require.Equal(t, "*utils.callersError", origin["type"])
+	require.Contains(t, origin["stack"].([]interface{})[0], "utils.newCallersError ")
+
+	origin = originOf(errors.Wrap(stderrors.New("plain"), "load"))
+	require.Equal(t, "*errors.withStack", origin["type"])
+	require.Contains(t, origin["stack"].([]interface{})[0], "utils.TestOriginStack")
+
+	require.Equal(t, zap.Skip(), OriginStack(stderrors.New("plain")))
+	require.Equal(t, zap.Skip(), OriginStack(nil))
+}
+
+// causeError wraps an error through Cause() only, like old pkg/errors style errors
+// causeError 仅通过 Cause() 包装错误，类似旧式 pkg/errors 风格的错误
+type causeError struct {
+	cause error
+}
+
+func (e *causeError) Error() string { return "cause: " + e.cause.Error() }
+
+func (e *causeError) Cause() error { return e.cause }
+
+// TestChildren tests listing the errors wrapped by an error
+// Validates Children follows Unwrap() []error, Unwrap() error and Cause() error, and returns nil for plain errors
+//
+// TestChildren 测试列出错误包装的错误
+// 验证 Children 跟随 Unwrap() []error、Unwrap() error 和 Cause() error，对普通错误返回 nil
+func TestChildren(t *testing.T) {
+	plainA := stderrors.New("a")
+	plainB := stderrors.New("b")
+
+	require.Equal(t, []error{plainA, plainB}, Children(stderrors.Join(plainA, plainB)))
+	require.Equal(t, []error{plainA}, Children(fmt.Errorf("ctx: %w", plainA)))
+	require.Equal(t, []error{plainA}, Children(&causeError{cause: plainA}))
+	require.Nil(t, Children(plainA))
+	require.Nil(t, Children(nil))
+}
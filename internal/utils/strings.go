@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// HasPrefixFold reports whether a starts with prefix under Unicode case-folding.
+// HasPrefixFold 判断在 Unicode 大小写折叠下 a 是否以 prefix 开头。
+func HasPrefixFold(a string, prefix string) bool {
+	for _, pr := range prefix {
+		if a == "" {
+			return false
+		}
+		ar, size := utf8.DecodeRuneInString(a)
+		if ar != pr && !strings.EqualFold(string(ar), string(pr)) {
+			return false
+		}
+		a = a[size:]
+	}
+	return true
+}
+
+// ContainsFold reports whether sub is within a under Unicode case-folding.
+// ContainsFold 判断在 Unicode 大小写折叠下 a 是否包含 sub。
+func ContainsFold(a string, sub string) bool {
+	for {
+		if HasPrefixFold(a, sub) {
+			return true
+		}
+		if a == "" {
+			return false
+		}
+		_, size := utf8.DecodeRuneInString(a)
+		a = a[size:]
+	}
+}
+
+// MissingSubstrings returns the indexes of the substrings not within a.
+// MissingSubstrings 返回不在 a 中的子串的索引。
+func MissingSubstrings(a string, subs []string) []int {
+	var res []int
+	for idx, sub := range subs {
+		if !strings.Contains(a, sub) {
+			res = append(res, idx)
+		}
+	}
+	return res
+}
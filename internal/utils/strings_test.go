@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestHasPrefixFold tests prefix matching under Unicode case-folding
+// Validates HasPrefixFold ignores case and rejects prefixes longer than the string
+//
+// TestHasPrefixFold 测试 Unicode 大小写折叠下的前缀匹配
+// 验证 HasPrefixFold 忽略大小写，并拒绝比字符串更长的前缀
+func TestHasPrefixFold(t *testing.T) {
+	require.True(t, HasPrefixFold("Bearer token", "bearer"))
+	require.True(t, HasPrefixFold("ΣΑΣ", "σα"))
+	require.True(t, HasPrefixFold("abc", ""))
+	require.False(t, HasPrefixFold("ab", "abc"))
+	require.False(t, HasPrefixFold("token", "bearer"))
+}
+
+// TestContainsFold tests substring matching under Unicode case-folding
+// Validates ContainsFold finds the substring at any position ignoring case
+//
+// TestContainsFold 测试 Unicode 大小写折叠下的子串匹配
+// 验证 ContainsFold 忽略大小写在任意位置找到子串
+func TestContainsFold(t *testing.T) {
+	require.True(t, ContainsFold("Content-Type: JSON", "json"))
+	require.True(t, ContainsFold("", ""))
+	require.False(t, ContainsFold("Content-Type", "xml"))
+}
+
+// TestMissingSubstrings tests locating the substrings not within the string
+// Validates MissingSubstrings returns the indexes of the missing substrings in order
+//
+// TestMissingSubstrings 测试定位不在字符串中的子串
+// 验证 MissingSubstrings 按顺序返回缺失子串的索引
+func TestMissingSubstrings(t *testing.T) {
+	require.Equal(t, []int{1, 3}, MissingSubstrings("SELECT id FROM t", []string{"SELECT", "WHERE", "FROM", "LIMIT"}))
+	require.Nil(t, MissingSubstrings("abc", []string{"a", "bc"}))
+}
@@ -0,0 +1,33 @@
+package utils
+
+import "reflect"
+
+// IsTypedNil reports whether v is a non-nil interface wrapping a nil pointer, map, slice, func or chan.
+// Such values pass v != nil checks but panic or misbehave when used, like an error holding a nil *MyError.
+//
+// IsTypedNil 判断 v 是否为包装了 nil 指针、map、切片、函数或通道的非 nil 接口。
+// 这类值可以通过 v != nil 检查，但在使用时会 panic 或行为异常，例如持有 nil *MyError 的 error。
+func IsTypedNil(v any) bool {
+	if v == nil {
+		return false
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+// HoldsTypedNil reports whether v points to an interface that wraps a nil value, like a *io.Reader holding a nil *os.File.
+// HoldsTypedNil 判断 v 是否指向一个包装了 nil 值的接口，例如持有 nil *os.File 的 *io.Reader。
+func HoldsTypedNil[T any](v *T) bool {
+	if v == nil {
+		return false
+	}
+	elem := reflect.ValueOf(v).Elem()
+	if elem.Kind() != reflect.Interface || elem.IsNil() {
+		return false
+	}
+	return IsTypedNil(elem.Interface())
+}
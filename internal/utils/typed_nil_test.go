@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestIsTypedNil tests typed-nil interface detection
+// Validates IsTypedNil reports nil pointers, maps, slices, funcs and chans wrapped in interfaces, and not untyped nil or set values
+//
+// TestIsTypedNil 测试类型化 nil 接口检测
+// 验证 IsTypedNil 识别包装在接口中的 nil 指针、map、切片、函数和通道，而不识别无类型 nil 或已设置的值
+func TestIsTypedNil(t *testing.T) {
+	var pathErr *os.PathError
+	var err error = pathErr
+	var reader io.Reader = (*os.File)(nil)
+
+	require.True(t, IsTypedNil(err))
+	require.True(t, IsTypedNil(reader))
+	require.True(t, IsTypedNil((map[string]int)(nil)))
+	require.True(t, IsTypedNil(([]int)(nil)))
+	require.True(t, IsTypedNil((func())(nil)))
+	require.True(t, IsTypedNil((chan int)(nil)))
+
+	require.False(t, IsTypedNil(nil))
+	require.False(t, IsTypedNil(errors.New("x")))
+	require.False(t, IsTypedNil(0))
+	require.False(t, IsTypedNil(""))
+	require.False(t, IsTypedNil(&os.PathError{}))
+	require.False(t, IsTypedNil([]int{}))
+}
+
+// TestHoldsTypedNil tests typed-nil detection behind pointers
+// Validates HoldsTypedNil reports pointers to interfaces wrapping nil values, and not nil pointers, nil interfaces or concrete types
+//
+// TestHoldsTypedNil 测试指针背后的类型化 nil 检测
+// 验证 HoldsTypedNil 识别指向包装了 nil 值的接口的指针，而不识别 nil 指针、nil 接口或具体类型
+func TestHoldsTypedNil(t *testing.T) {
+	var reader io.Reader = (*os.File)(nil)
+	require.True(t, HoldsTypedNil(&reader))
+
+	var unset io.Reader
+	require.False(t, HoldsTypedNil(&unset))
+	require.False(t, HoldsTypedNil[io.Reader](nil))
+
+	var file *os.File
+	require.False(t, HoldsTypedNil(&file))
+
+	var set io.Reader = os.Stdin
+	require.False(t, HoldsTypedNil(&set))
+}
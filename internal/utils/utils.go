@@ -7,8 +7,16 @@
 // 不在此模块外使用，作为支持机制
 package utils
 
+import "fmt"
+
 // Zero returns the zero value of type T using named return value initialization.
 // Zero 使用命名返回值初始化返回类型 T 的零值。
 func Zero[T any]() (x T) {
 	return x
 }
+
+// TypeName returns the name of type T, even when T is an interface type.
+// TypeName 返回类型 T 的名称，即使 T 是接口类型。
+func TypeName[T any]() string {
+	return fmt.Sprintf("%T", (*T)(nil))[1:]
+}
@@ -6,6 +6,7 @@
 package utils
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -21,3 +22,15 @@ func TestZero(t *testing.T) {
 	require.Equal(t, "", Zero[string]())
 	require.Equal(t, false, Zero[bool]())
 }
+
+// TestTypeName tests type name rendering
+// Validates TypeName names concrete and interface types
+//
+// TestTypeName 测试类型名称渲染
+// 验证 TypeName 能命名具体类型和接口类型
+func TestTypeName(t *testing.T) {
+	require.Equal(t, "int", TypeName[int]())
+	require.Equal(t, "[]string", TypeName[[]string]())
+	require.Equal(t, "error", TypeName[error]())
+	require.Equal(t, "fmt.Stringer", TypeName[fmt.Stringer]())
+}
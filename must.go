@@ -10,6 +10,8 @@
 package must
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	"github.com/yyle88/must/internal/mustskip/must2"
 	"github.com/yyle88/must/internal/utils"
@@ -25,27 +27,30 @@ func True(v bool) {
 	}
 }
 
-// Done expects no error. Panics if the provided error is non-nil.
-// Done 期望没有错误。如果提供的错误不为 nil，则触发 panic。
+// Done expects no error. Panics if the provided error is non-nil, logging the stack where the error originated when the chain carries one (like pkg/errors).
+// Done 期望没有错误。如果提供的错误不为 nil，则触发 panic；当错误链携带堆栈（例如 pkg/errors）时，记录错误产生位置的堆栈。
 func Done(err error) {
 	if err != nil {
-		zaplog.ZAPS.Skip1.LOG.Panic("EXPECTED NO ERROR(BUT HAS ERROR)", zap.Error(err))
+		zaplog.ZAPS.Skip1.LOG.Panic("EXPECTED NO ERROR(BUT HAS ERROR)", zap.Error(err), utils.OriginStack(err))
 	}
 }
 
-// Must expects no error. Panics if the provided error is non-nil.
-// Must 期望没有错误。如果提供的错误不为 nil，则触发 panic。
+// Must expects no error. Panics if the provided error is non-nil, logging the stack where the error originated when the chain carries one (like pkg/errors).
+// Must 期望没有错误。如果提供的错误不为 nil，则触发 panic；当错误链携带堆栈（例如 pkg/errors）时，记录错误产生位置的堆栈。
 func Must(err error) {
 	if err != nil {
-		zaplog.ZAPS.Skip1.LOG.Panic("HAS ERROR(SHOULD BE NO ERROR)", zap.Error(err))
+		zaplog.ZAPS.Skip1.LOG.Panic("HAS ERROR(SHOULD BE NO ERROR)", zap.Error(err), utils.OriginStack(err))
 	}
 }
 
-// Nice expects a non-zero value. Panics if the value is zero, returns the value if non-zero.
-// Nice 期望一个非零值。如果值为零，则触发 panic；如果值非零，则返回该值。
+// Nice expects a non-zero value. Panics if the value is zero or a typed nil (an interface wrapping a nil pointer), returns the value if non-zero.
+// Nice 期望一个非零值。如果值为零或为类型化 nil（包装了 nil 指针的接口），则触发 panic；如果值非零，则返回该值。
 func Nice[V comparable](a V) V {
 	if a == utils.Zero[V]() {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", zap.Any("a", a))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", utils.Any("a", a))
+	}
+	if utils.IsTypedNil(a) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", a)))
 	}
 	return a
 }
@@ -54,7 +59,7 @@ func Nice[V comparable](a V) V {
 // Zero 期望值为零。如果值不为零，则触发 panic。
 func Zero[V comparable](a V) {
 	if a != utils.Zero[V]() {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS NOT ZERO(SHOULD BE ZERO)", zap.Any("a", a))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS NOT ZERO(SHOULD BE ZERO)", utils.Any("a", a))
 	}
 }
 
@@ -62,7 +67,7 @@ func Zero[V comparable](a V) {
 // None 期望值为零（空）。如果值不为零，则触发 panic。
 func None[V comparable](a V) {
 	if a != utils.Zero[V]() {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS NOT ZERO(SHOULD BE ZERO)", zap.Any("a", a))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS NOT ZERO(SHOULD BE ZERO)", utils.Any("a", a))
 	}
 }
 
@@ -74,12 +79,15 @@ func Null[T any](v *T) {
 	}
 }
 
-// Full expects the value to be non-nil. Panics if the value is nil.
-// Full 期望值为非 nil。如果值为 nil，则触发 panic。
+// Full expects the value to be non-nil. Panics if the value is nil, or points to an interface wrapping a nil value.
+// Full 期望值为非 nil。如果值为 nil，或指向包装了 nil 值的接口，则触发 panic。
 func Full[T any](v *T) *T {
 	if v == nil {
 		zaplog.ZAPS.Skip1.LOG.Panic("VALUE ABSENT(SHOULD BE PRESENT)")
 	}
+	if utils.HoldsTypedNil(v) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", *v)))
+	}
 	return v
 }
 
@@ -87,7 +95,7 @@ func Full[T any](v *T) *T {
 // Equals 期望值相等。如果值不相等，则触发 panic。
 func Equals[V comparable](a, b V) {
 	if a != b {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", zap.Any("a", a), zap.Any("b", b))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", utils.Any("a", a), utils.Any("b", b))
 	}
 }
 
@@ -95,7 +103,7 @@ func Equals[V comparable](a, b V) {
 // Same 期望值相等。如果值不相等，则触发 panic。
 func Same[V comparable](a, b V) {
 	if a != b {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", zap.Any("a", a), zap.Any("b", b))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", utils.Any("a", a), utils.Any("b", b))
 	}
 }
 
@@ -121,7 +129,7 @@ func Sane[V comparable](a, b V) V {
 // Diff 期望值不同。如果值相同，则触发 panic。
 func Diff[V comparable](a, b V) {
 	if a == b {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUES ARE SAME(SHOULD BE DIFFERENT)", zap.Any("a", a), zap.Any("b", b))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES ARE SAME(SHOULD BE DIFFERENT)", utils.Any("a", a), utils.Any("b", b))
 	}
 }
 
@@ -129,7 +137,7 @@ func Diff[V comparable](a, b V) {
 // Different 期望值不同。如果值相同，则触发 panic。
 func Different[V comparable](a, b V) {
 	if a == b {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUES ARE SAME(SHOULD BE DIFFERENT)", zap.Any("a", a), zap.Any("b", b))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES ARE SAME(SHOULD BE DIFFERENT)", utils.Any("a", a), utils.Any("b", b))
 	}
 }
 
@@ -137,7 +145,7 @@ func Different[V comparable](a, b V) {
 // Is 期望相等，不是 errors.Is 的逻辑，而是 Equals 的逻辑。如果值不相等，则触发 panic。
 func Is[V comparable](a, b V) {
 	if a != b {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", zap.Any("a", a), zap.Any("b", b))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", utils.Any("a", a), utils.Any("b", b))
 	}
 }
 
@@ -145,23 +153,29 @@ func Is[V comparable](a, b V) {
 // Ise 期望错误相等，类似于 errors.Is 的行为。如果错误不相等，则触发 panic。
 func Ise(err, target error) {
 	if !errors.Is(err, target) {
-		zaplog.ZAPS.Skip1.LOG.Panic("ERROR MISMATCH(NOT SAME ERROR)", zap.Error(err), zap.Error(target))
+		zaplog.ZAPS.Skip1.LOG.Panic("ERROR MISMATCH(NOT SAME ERROR)", zap.Error(err), zap.Error(target), ErrorChain(err))
 	}
 }
 
-// Ok expects a non-zero value. Panics if the value is zero.
-// Ok 期望一个非零值。如果值为零，则触发 panic。
+// Ok expects a non-zero value. Panics if the value is zero or a typed nil.
+// Ok 期望一个非零值。如果值为零或为类型化 nil，则触发 panic。
 func Ok[V comparable](a V) {
 	if a == utils.Zero[V]() {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", zap.Any("a", a))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", utils.Any("a", a))
+	}
+	if utils.IsTypedNil(a) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", a)))
 	}
 }
 
-// OK expects a non-zero value. Panics if the value is zero. Provides an alternative name based on preference.
-// OK 期望一个非零值。如果值为零，则触发 panic。提供一个偏好的替代名称。
+// OK expects a non-zero value. Panics if the value is zero or a typed nil. Provides an alternative name based on preference.
+// OK 期望一个非零值。如果值为零或为类型化 nil，则触发 panic。提供一个偏好的替代名称。
 func OK[V comparable](a V) {
 	if a == utils.Zero[V]() {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", zap.Any("a", a))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", utils.Any("a", a))
+	}
+	if utils.IsTypedNil(a) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", a)))
 	}
 }
 
@@ -189,21 +203,27 @@ func False(v bool) {
 	}
 }
 
-// Cause expects an error to be present. Panics if error is nil, returns the error.
-// Cause 期望存在错误。如果错误为 nil，则触发 panic；否则返回该错误。
+// Cause expects an error to be present. Panics if error is nil or a typed nil (like a nil *MyError), returns the error.
+// Cause 期望存在错误。如果错误为 nil 或为类型化 nil（例如 nil *MyError），则触发 panic；否则返回该错误。
 func Cause(err error) error {
 	if err == nil {
 		zaplog.ZAPS.Skip1.LOG.Panic("ERROR ABSENT(SHOULD BE PRESENT)")
 	}
+	if utils.IsTypedNil(err) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", err)))
+	}
 	return err
 }
 
-// Wrong expects an error to be present. Panics if error is nil.
-// Wrong 期望存在错误。如果错误为 nil，则触发 panic。
+// Wrong expects an error to be present. Panics if error is nil or a typed nil.
+// Wrong 期望存在错误。如果错误为 nil 或为类型化 nil，则触发 panic。
 func Wrong(err error) {
 	if err == nil {
 		zaplog.ZAPS.Skip1.LOG.Panic("ERROR ABSENT(SHOULD BE PRESENT)")
 	}
+	if utils.IsTypedNil(err) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", err)))
+	}
 }
 
 // Have checks that the slice is not vacant. Panics if the slice is vacant.
@@ -239,7 +259,7 @@ func In[T comparable](v T, a []T) {
 			return
 		}
 	}
-	zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Any("v", v), zap.Int("len", len(a)))
+	zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", utils.Any("v", v), zap.Int("len", len(a)))
 }
 
 // Contains checks if the slice contains the value. Panics if the value is not found.
@@ -250,5 +270,5 @@ func Contains[T comparable](a []T, v T) {
 			return
 		}
 	}
-	zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Int("len", len(a)), zap.Any("v", v))
+	zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Int("len", len(a)), utils.Any("v", v))
 }
@@ -0,0 +1,142 @@
+package must
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// As expects the error chain to contain an error of type E, using the logic of errors.As. Panics if not found, returns the found error.
+// As 期望错误链中包含 E 类型的错误，使用 errors.As 的逻辑。如果找不到则触发 panic，否则返回找到的错误。
+func As[E error](err error) E {
+	var target E
+	if !errors.As(err, &target) {
+		zaplog.ZAPS.Skip1.LOG.Panic("ERROR TYPE NOT IN CHAIN(SHOULD BE IN CHAIN)", zap.String("expected", utils.TypeName[E]()), ErrorChain(err))
+	}
+	return target
+}
+
+// NotIs expects the errors not to match, using the logic of errors.Is. Panics if matching.
+// NotIs 期望错误不匹配，使用 errors.Is 的逻辑。如果匹配，则触发 panic。
+func NotIs(err, target error) {
+	if errors.Is(err, target) {
+		zaplog.ZAPS.Skip1.LOG.Panic("ERROR MATCHES(SHOULD NOT MATCH)", zap.NamedError("target", target), ErrorChain(err))
+	}
+}
+
+// ErrorContains expects an error whose message contains the substring. Panics if error is nil or the message does not contain it.
+// ErrorContains 期望错误信息包含该子串。如果错误为 nil 或信息不包含该子串，则触发 panic。
+func ErrorContains(err error, substr string) {
+	if err == nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("ERROR ABSENT(SHOULD BE PRESENT)", zap.String("substr", substr))
+	}
+	if !strings.Contains(err.Error(), substr) {
+		zaplog.ZAPS.Skip1.LOG.Panic("ERROR MESSAGE MISMATCH(SHOULD CONTAIN)", zap.String("substr", substr), ErrorChain(err))
+	}
+}
+
+// ErrorMatches expects an error whose message matches the regular expression. Panics if error is nil, the pattern is invalid or the message does not match.
+// ErrorMatches 期望错误信息匹配该正则表达式。如果错误为 nil、表达式无效或信息不匹配，则触发 panic。
+func ErrorMatches(err error, pattern string) {
+	expr, compileErr := regexp.Compile(pattern)
+	if compileErr != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("PATTERN INVALID(SHOULD BE VALID)", zap.String("pattern", pattern), zap.NamedError("reason", compileErr))
+	}
+	if err == nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("ERROR ABSENT(SHOULD BE PRESENT)", zap.String("pattern", pattern))
+	}
+	if !expr.MatchString(err.Error()) {
+		zaplog.ZAPS.Skip1.LOG.Panic("ERROR MESSAGE MISMATCH(SHOULD MATCH)", zap.String("pattern", pattern), ErrorChain(err))
+	}
+}
+
+// ErrorChain builds a zap field logging each wrapped layer of the error, depth first, including errors.Join branches.
+// Each layer logs its depth, type, own message (without the wrapped layer's text) and, for layers carrying one (like pkg/errors), the stack trace.
+//
+// ErrorChain 构建一个 zap 字段，深度优先记录错误的每个包装层，包括 errors.Join 的分支。
+// 每层记录其深度、类型、自身信息（不含被包装层的文本），对于携带堆栈的层（例如 pkg/errors）还记录堆栈。
+func ErrorChain(err error) zap.Field {
+	if err == nil {
+		return zap.Skip()
+	}
+	var layers errorLayers
+	layers.walk(err, 0, -1)
+	return zap.Array("error_chain", layers)
+}
+
+// errorLayer is one layer of an error chain
+// errorLayer 是错误链中的一层
+type errorLayer struct {
+	depth   int
+	branch  int // index in the errors.Join branches, -1 when not a branch
+	kind    string
+	message string
+	stack   string
+}
+
+// errorLayers renders the layers of an error chain as a zap array
+// errorLayers 将错误链的各层渲染为 zap 数组
+type errorLayers []errorLayer
+
+// walk appends the layer of err and then its wrapped layers, depth first
+// walk 追加 err 所在的层，然后深度优先追加其包装的层
+func (layers *errorLayers) walk(err error, depth int, branch int) {
+	layer := errorLayer{depth: depth, branch: branch, kind: fmt.Sprintf("%T", err), message: err.Error()}
+	if frames := utils.StackFrames(err); frames != nil {
+		layer.stack = strings.Join(frames, "\n")
+	}
+	children := utils.Children(err)
+	if len(children) == 1 && children[0] != nil {
+		// keep only the text the layer adds to the wrapped one, like "open config" of "open config: not found"
+		child := children[0].Error()
+		layer.message = strings.TrimSuffix(strings.TrimSuffix(layer.message, child), ": ")
+	}
+	*layers = append(*layers, layer)
+	if depth+1 >= utils.ChainDepthLimit {
+		return
+	}
+	for idx, child := range children {
+		if child == nil {
+			continue
+		}
+		if len(children) > 1 {
+			layers.walk(child, depth+1, idx)
+		} else {
+			layers.walk(child, depth+1, -1)
+		}
+	}
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler
+// MarshalLogArray 实现 zapcore.ArrayMarshaler
+func (layers errorLayers) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, layer := range layers {
+		if err := enc.AppendObject(layer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler
+// MarshalLogObject 实现 zapcore.ObjectMarshaler
+func (layer errorLayer) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt("depth", layer.depth)
+	if layer.branch >= 0 {
+		enc.AddInt("branch", layer.branch)
+	}
+	enc.AddString("type", layer.kind)
+	if layer.message != "" {
+		enc.AddString("message", layer.message)
+	}
+	if layer.stack != "" {
+		enc.AddString("stack", layer.stack)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package must_test
+
+import (
+	stderrors "errors"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must"
+	"github.com/yyle88/must/internal/tests"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// TestAs tests error type assertion across the chain
+// Validates As returns the typed error when the chain contains it and panics when not or when error is nil
+//
+// TestAs 测试错误链中的错误类型断言
+// 验证 As 在错误链包含该类型时返回该错误，在不包含或错误为 nil 时 panic
+func TestAs(t *testing.T) {
+	_, cause := os.Open("/not-exist-path-for-must-test")
+	err := errors.Wrap(cause, "open config")
+
+	pathErr := must.As[*fs.PathError](err)
+	require.Equal(t, "/not-exist-path-for-must-test", pathErr.Path)
+
+	require.Panics(t, func() {
+		must.As[*os.LinkError](err)
+	})
+
+	require.Panics(t, func() {
+		must.As[*fs.PathError](nil)
+	})
+}
+
+// TestNotIs tests error mismatch assertion using errors.Is
+// Validates NotIs passes when the chain does not match and panics when it does
+//
+// TestNotIs 测试使用 errors.Is 的错误不匹配断言
+// 验证 NotIs 在错误链不匹配时通过，在匹配时 panic
+func TestNotIs(t *testing.T) {
+	erx := errors.New("wa")
+	must.NotIs(errors.New("wa"), erx)
+	must.NotIs(nil, erx)
+
+	require.Panics(t, func() {
+		must.NotIs(errors.WithMessage(erx, "ha"), erx)
+	})
+
+	require.Panics(t, func() {
+		must.NotIs(stderrors.Join(errors.New("x"), erx), erx)
+	})
+}
+
+// TestErrorContains tests error message substring assertion
+// Validates ErrorContains passes when the message contains the substring and panics when not or when error is nil
+//
+// TestErrorContains 测试错误信息子串断言
+// 验证 ErrorContains 在信息包含子串时通过，在不包含或错误为 nil 时 panic
+func TestErrorContains(t *testing.T) {
+	err := errors.Wrap(errors.New("not found"), "load user")
+	must.ErrorContains(err, "load user: not found")
+	must.ErrorContains(err, "not found")
+
+	require.Panics(t, func() {
+		must.ErrorContains(err, "timeout")
+	})
+
+	require.Panics(t, func() {
+		must.ErrorContains(nil, "")
+	})
+}
+
+// TestErrorMatches tests error message regular expression assertion
+// Validates ErrorMatches passes when the message matches and panics when not, when error is nil, or when the pattern is invalid
+//
+// TestErrorMatches 测试错误信息正则表达式断言
+// 验证 ErrorMatches 在信息匹配时通过，在不匹配、错误为 nil 或表达式无效时 panic
+func TestErrorMatches(t *testing.T) {
+	err := errors.Errorf("user %d not found", 42)
+	must.ErrorMatches(err, `^user \d+ not found$`)
+
+	require.Panics(t, func() {
+		must.ErrorMatches(err, `^order \d+`)
+	})
+
+	require.Panics(t, func() {
+		must.ErrorMatches(nil, `.*`)
+	})
+
+	require.Panics(t, func() {
+		must.ErrorMatches(err, `(`)
+	})
+}
+
+// TestErrorChain tests error chain logging
+// Validates ErrorChain logs each wrapped layer depth first with own messages, Join branches and pkg/errors stacks
+//
+// TestErrorChain 测试错误链日志
+// 验证 ErrorChain 深度优先记录每个包装层的自身信息、Join 分支和 pkg/errors 堆栈
+func TestErrorChain(t *testing.T) {
+	base := stderrors.New("not found")
+	err := errors.WithMessage(stderrors.Join(base, errors.New("timeout")), "load user")
+
+	enc := zapcore.NewMapObjectEncoder()
+	must.ErrorChain(err).AddTo(enc)
+	layers := enc.Fields["error_chain"].([]interface{})
+	require.Len(t, layers, 4)
+
+	require.Equal(t, map[string]interface{}{"depth": 0, "type": "*errors.withMessage", "message": "load user"}, layers[0])
+	require.Equal(t, map[string]interface{}{"depth": 1, "type": "*errors.joinError", "message": "not found\ntimeout"}, layers[1])
+	require.Equal(t, map[string]interface{}{"depth": 2, "branch": 0, "type": "*errors.errorString", "message": "not found"}, layers[2])
+
+	last := layers[3].(map[string]interface{})
+	require.Equal(t, 2, last["depth"])
+	require.Equal(t, 1, last["branch"])
+	require.Equal(t, "*errors.fundamental", last["type"])
+	require.Equal(t, "timeout", last["message"])
+	require.Contains(t, last["stack"], "must_test.TestErrorChain")
+
+	enc = zapcore.NewMapObjectEncoder()
+	must.ErrorChain(errors.Wrap(base, "load user")).AddTo(enc)
+	layers = enc.Fields["error_chain"].([]interface{})
+	require.Len(t, layers, 3)
+	require.Equal(t, "*errors.withStack", layers[0].(map[string]interface{})["type"])
+	require.NotContains(t, layers[0], "message") // the stack layer adds no text
+	require.Contains(t, layers[0].(map[string]interface{})["stack"], "must_test.TestErrorChain")
+	require.Equal(t, "load user", layers[1].(map[string]interface{})["message"])
+	require.Equal(t, "not found", layers[2].(map[string]interface{})["message"])
+
+	require.Equal(t, zap.Skip(), must.ErrorChain(nil))
+}
+
+func loadConfig() error {
+	return errors.New("config not found")
+}
+
+// TestDoneOriginStack tests origin stack logging in no-error assertions
+// Validates Done and Must log the stack where a pkg/errors error originated, alongside the caller of the assertion
+//
+// TestDoneOriginStack 测试无错误断言中的产生位置堆栈日志
+// 验证 Done 和 Must 记录 pkg/errors 错误产生位置的堆栈，以及断言的调用位置
+func TestDoneOriginStack(t *testing.T) {
+	logs := tests.ObserveLogs(t, zap.AddCaller())
+
+	err := errors.WithMessage(loadConfig(), "start server")
+	require.Panics(t, func() {
+		must.Done(err)
+	})
+	require.Panics(t, func() {
+		must.Must(err)
+	})
+
+	require.Equal(t, 2, logs.Len())
+	for _, entry := range logs.All() {
+		require.Contains(t, entry.Caller.File, "must_errors_test.go")
+		origin := entry.ContextMap()["error_origin"].(map[string]interface{})
+		require.Equal(t, "*errors.fundamental", origin["type"])
+		require.Contains(t, origin["stack"].([]interface{})[0], "must_test.loadConfig ")
+	}
+
+	require.Panics(t, func() {
+		must.Done(stderrors.New("plain"))
+	})
+	require.NotContains(t, logs.All()[2].ContextMap(), "error_origin")
+}
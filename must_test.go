@@ -8,6 +8,9 @@
 package must_test
 
 import (
+	"io"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -430,3 +433,79 @@ func TestFull(t *testing.T) {
 		must.Full(example)
 	})
 }
+
+type typedNilError struct{}
+
+func (e *typedNilError) Error() string { return "typed nil error" }
+
+// TestNiceTypedNil tests typed-nil detection in non-zero assertions
+// Checks Nice, Ok, OK and SameNice panic when an interface wraps a nil pointer, map or slice
+//
+// TestNiceTypedNil 测试非零断言中的类型化 nil 检测
+// 检查 Nice、Ok、OK 和 SameNice 在接口包装了 nil 指针、map 或切片时 panic
+func TestNiceTypedNil(t *testing.T) {
+	var reader io.Reader = strings.NewReader("abc")
+	require.Equal(t, reader, must.Nice(reader))
+	must.Ok(reader)
+	must.OK(reader)
+
+	var file *os.File
+	var typedNil io.Reader = file
+	require.Panics(t, func() {
+		must.Nice(typedNil)
+	})
+	require.Panics(t, func() {
+		must.Ok(typedNil)
+	})
+	require.Panics(t, func() {
+		must.OK(typedNil)
+	})
+	require.Panics(t, func() {
+		must.SameNice(typedNil, typedNil)
+	})
+	require.Panics(t, func() {
+		must.Nice[any]((map[string]int)(nil))
+	})
+	require.Panics(t, func() {
+		must.Nice[any](([]int)(nil))
+	})
+}
+
+// TestFullTypedNil tests typed-nil detection in non-nil pointer assertion
+// Checks Full panics when the pointer points to an interface wrapping a nil value
+//
+// TestFullTypedNil 测试非 nil 指针断言中的类型化 nil 检测
+// 检查 Full 在指针指向包装了 nil 值的接口时 panic
+func TestFullTypedNil(t *testing.T) {
+	var reader io.Reader = strings.NewReader("abc")
+	require.Equal(t, &reader, must.Full(&reader))
+
+	var unset io.Reader
+	require.Equal(t, &unset, must.Full(&unset)) // the pointer is set, the interface is simply nil
+
+	var file *os.File
+	var typedNil io.Reader = file
+	require.Panics(t, func() {
+		must.Full(&typedNil)
+	})
+}
+
+// TestCauseTypedNil tests typed-nil detection in error presence assertions
+// Checks Cause and Wrong panic when the error wraps a nil pointer
+//
+// TestCauseTypedNil 测试错误存在断言中的类型化 nil 检测
+// 检查 Cause 和 Wrong 在错误包装了 nil 指针时 panic
+func TestCauseTypedNil(t *testing.T) {
+	var cause *typedNilError
+	var err error = cause
+	require.Panics(t, func() {
+		must.Cause(err)
+	})
+	require.Panics(t, func() {
+		must.Wrong(err)
+	})
+
+	err = &typedNilError{}
+	require.Equal(t, err, must.Cause(err))
+	must.Wrong(err)
+}
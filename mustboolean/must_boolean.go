@@ -26,3 +26,79 @@ func Conflict(bs ...bool) {
 		}
 	}
 }
+
+// ExactlyOne ensures exactly one boolean is true. Panics with the indexes of true values if not.
+// ExactlyOne 确保恰好一个布尔值为 true。否则触发 panic 并记录为 true 的索引。
+func ExactlyOne(bs ...bool) {
+	if idxs := trueIndexes(bs); len(idxs) != 1 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT EXACTLY ONE TRUE(SHOULD BE EXACTLY ONE)", zap.Ints("true_indexes", idxs), zap.Int("len", len(bs)))
+	}
+}
+
+// AtLeastOne ensures at least one boolean is true. Panics if none is true.
+// AtLeastOne 确保至少一个布尔值为 true。如果都不为 true，则触发 panic。
+func AtLeastOne(bs ...bool) {
+	if idxs := trueIndexes(bs); len(idxs) == 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NO TRUE VALUE(SHOULD BE AT LEAST ONE)", zap.Ints("true_indexes", idxs), zap.Int("len", len(bs)))
+	}
+}
+
+// AllTrue ensures each boolean is true. Panics with the indexes of true values if any is false.
+// AllTrue 确保每个布尔值都为 true。如果有 false，则触发 panic 并记录为 true 的索引。
+func AllTrue(bs ...bool) {
+	if idxs := trueIndexes(bs); len(idxs) != len(bs) {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT ALL TRUE(SHOULD BE ALL TRUE)", zap.Ints("true_indexes", idxs), zap.Int("len", len(bs)))
+	}
+}
+
+// AllFalse ensures each boolean is false. Panics with the indexes of true values if any is true.
+// AllFalse 确保每个布尔值都为 false。如果有 true，则触发 panic 并记录为 true 的索引。
+func AllFalse(bs ...bool) {
+	if idxs := trueIndexes(bs); len(idxs) != 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT ALL FALSE(SHOULD BE ALL FALSE)", zap.Ints("true_indexes", idxs), zap.Int("len", len(bs)))
+	}
+}
+
+// AtMostN ensures at most n booleans are true. Panics with the indexes of true values if more are true.
+// AtMostN 确保最多 n 个布尔值为 true。如果更多为 true，则触发 panic 并记录为 true 的索引。
+func AtMostN(n int, bs ...bool) {
+	if idxs := trueIndexes(bs); len(idxs) > n {
+		zaplog.ZAPS.Skip1.LOG.Panic("TOO MANY TRUE VALUES(SHOULD BE AT MOST N)", zap.Ints("true_indexes", idxs), zap.Int("n", n), zap.Int("len", len(bs)))
+	}
+}
+
+// Implies ensures that when a is true, b is true as well. Panics if a is true and b is false.
+// Implies 确保当 a 为 true 时 b 也为 true。如果 a 为 true 而 b 为 false，则触发 panic。
+func Implies(a, b bool) {
+	if a && !b {
+		zaplog.ZAPS.Skip1.LOG.Panic("IMPLICATION BROKEN(A SHOULD IMPLY B)", zap.Ints("true_indexes", trueIndexes([]bool{a, b})), zap.Bool("a", a), zap.Bool("b", b))
+	}
+}
+
+// Iff ensures a and b are both true or both false. Panics if they differ.
+// Iff 确保 a 和 b 同时为 true 或同时为 false。如果不同，则触发 panic。
+func Iff(a, b bool) {
+	if a != b {
+		zaplog.ZAPS.Skip1.LOG.Panic("EQUIVALENCE BROKEN(A SHOULD BE SAME AS B)", zap.Ints("true_indexes", trueIndexes([]bool{a, b})), zap.Bool("a", a), zap.Bool("b", b))
+	}
+}
+
+// Xor ensures exactly one of a and b is true. Panics if both are true or both are false.
+// Xor 确保 a 和 b 中恰好一个为 true。如果都为 true 或都为 false，则触发 panic。
+func Xor(a, b bool) {
+	if a == b {
+		zaplog.ZAPS.Skip1.LOG.Panic("XOR BROKEN(SHOULD BE EXACTLY ONE)", zap.Ints("true_indexes", trueIndexes([]bool{a, b})), zap.Bool("a", a), zap.Bool("b", b))
+	}
+}
+
+// trueIndexes returns the indexes of true values
+// trueIndexes 返回为 true 的索引
+func trueIndexes(bs []bool) []int {
+	idxs := make([]int, 0, len(bs))
+	for idx, b := range bs {
+		if b {
+			idxs = append(idxs, idx)
+		}
+	}
+	return idxs
+}
@@ -0,0 +1,141 @@
+package mustboolean
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// NamedFlag is a boolean with a name (like a CLI flag "--json"), letting failures log the name instead of the index
+// NamedFlag 是带名称的布尔值（例如命令行参数 "--json"），使失败时记录名称而不是索引
+type NamedFlag struct {
+	Name  string // Name of the flag // 参数名称
+	Value bool   // Value of the flag // 参数值
+}
+
+// Flag creates a NamedFlag with the name and value.
+// Flag 使用名称和值创建 NamedFlag。
+func Flag(name string, value bool) NamedFlag {
+	return NamedFlag{Name: name, Value: value}
+}
+
+// Flags converts the map into NamedFlags sorted by name, so the failures are stable.
+// Flags 将 map 转换为按名称排序的 NamedFlag，使失败信息稳定。
+func Flags(m map[string]bool) []NamedFlag {
+	res := make([]NamedFlag, 0, len(m))
+	for name, value := range m {
+		res = append(res, Flag(name, value))
+	}
+	slices.SortFunc(res, func(a, b NamedFlag) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return res
+}
+
+// ConflictFlags ensures at most one flag is true. Panics with the names of the first two true flags if multiple are true.
+// ConflictFlags 确保最多一个参数为 true。如果有多个为 true，则触发 panic 并记录前两个为 true 的参数名称。
+func ConflictFlags(flags ...NamedFlag) {
+	firstIndex := -1
+	for idx, flag := range flags {
+		if flag.Value {
+			if firstIndex >= 0 {
+				zaplog.ZAPS.Skip1.LOG.Panic("conflict: multiple true flags", zap.String("first", flags[firstIndex].Name), zap.String("second", flag.Name), zap.Strings("true_flags", trueNames(flags)))
+			}
+			firstIndex = idx
+		}
+	}
+}
+
+// ExactlyOneFlags ensures exactly one flag is true. Panics with the names of true flags if not.
+// ExactlyOneFlags 确保恰好一个参数为 true。否则触发 panic 并记录为 true 的参数名称。
+func ExactlyOneFlags(flags ...NamedFlag) {
+	if names := trueNames(flags); len(names) != 1 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT EXACTLY ONE TRUE(SHOULD BE EXACTLY ONE)", zap.Strings("true_flags", names), zap.Strings("flags", allNames(flags)))
+	}
+}
+
+// AtLeastOneFlags ensures at least one flag is true. Panics with the flag names if none is true.
+// AtLeastOneFlags 确保至少一个参数为 true。如果都不为 true，则触发 panic 并记录参数名称。
+func AtLeastOneFlags(flags ...NamedFlag) {
+	if names := trueNames(flags); len(names) == 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NO TRUE VALUE(SHOULD BE AT LEAST ONE)", zap.Strings("true_flags", names), zap.Strings("flags", allNames(flags)))
+	}
+}
+
+// AllTrueFlags ensures each flag is true. Panics with the names of false flags if any is false.
+// AllTrueFlags 确保每个参数都为 true。如果有 false，则触发 panic 并记录为 false 的参数名称。
+func AllTrueFlags(flags ...NamedFlag) {
+	var falseNames []string
+	for _, flag := range flags {
+		if !flag.Value {
+			falseNames = append(falseNames, flag.Name)
+		}
+	}
+	if len(falseNames) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT ALL TRUE(SHOULD BE ALL TRUE)", zap.Strings("false_flags", falseNames), zap.Strings("true_flags", trueNames(flags)))
+	}
+}
+
+// AllFalseFlags ensures each flag is false. Panics with the names of true flags if any is true.
+// AllFalseFlags 确保每个参数都为 false。如果有 true，则触发 panic 并记录为 true 的参数名称。
+func AllFalseFlags(flags ...NamedFlag) {
+	if names := trueNames(flags); len(names) != 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT ALL FALSE(SHOULD BE ALL FALSE)", zap.Strings("true_flags", names), zap.Strings("flags", allNames(flags)))
+	}
+}
+
+// AtMostNFlags ensures at most n flags are true. Panics with the names of true flags if more are true.
+// AtMostNFlags 确保最多 n 个参数为 true。如果更多为 true，则触发 panic 并记录为 true 的参数名称。
+func AtMostNFlags(n int, flags ...NamedFlag) {
+	if names := trueNames(flags); len(names) > n {
+		zaplog.ZAPS.Skip1.LOG.Panic("TOO MANY TRUE VALUES(SHOULD BE AT MOST N)", zap.Strings("true_flags", names), zap.Int("n", n), zap.Strings("flags", allNames(flags)))
+	}
+}
+
+// ImpliesFlags ensures that when flag a is true, flag b is true as well. Panics with both names if a is true and b is false.
+// ImpliesFlags 确保当参数 a 为 true 时参数 b 也为 true。如果 a 为 true 而 b 为 false，则触发 panic 并记录两个名称。
+func ImpliesFlags(a, b NamedFlag) {
+	if a.Value && !b.Value {
+		zaplog.ZAPS.Skip1.LOG.Panic("IMPLICATION BROKEN(A SHOULD IMPLY B)", zap.String("a", a.Name), zap.Bool("a_value", a.Value), zap.String("b", b.Name), zap.Bool("b_value", b.Value))
+	}
+}
+
+// IffFlags ensures flags a and b are both true or both false. Panics with both names if they differ.
+// IffFlags 确保参数 a 和 b 同时为 true 或同时为 false。如果不同，则触发 panic 并记录两个名称。
+func IffFlags(a, b NamedFlag) {
+	if a.Value != b.Value {
+		zaplog.ZAPS.Skip1.LOG.Panic("EQUIVALENCE BROKEN(A SHOULD BE SAME AS B)", zap.String("a", a.Name), zap.Bool("a_value", a.Value), zap.String("b", b.Name), zap.Bool("b_value", b.Value))
+	}
+}
+
+// XorFlags ensures exactly one of flags a and b is true. Panics with both names if both are true or both are false.
+// XorFlags 确保参数 a 和 b 中恰好一个为 true。如果都为 true 或都为 false，则触发 panic 并记录两个名称。
+func XorFlags(a, b NamedFlag) {
+	if a.Value == b.Value {
+		zaplog.ZAPS.Skip1.LOG.Panic("XOR BROKEN(SHOULD BE EXACTLY ONE)", zap.String("a", a.Name), zap.Bool("a_value", a.Value), zap.String("b", b.Name), zap.Bool("b_value", b.Value))
+	}
+}
+
+// trueNames returns the names of true flags
+// trueNames 返回为 true 的参数名称
+func trueNames(flags []NamedFlag) []string {
+	names := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		if flag.Value {
+			names = append(names, flag.Name)
+		}
+	}
+	return names
+}
+
+// allNames returns the names of the flags
+// allNames 返回所有参数名称
+func allNames(flags []NamedFlag) []string {
+	names := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		names = append(names, flag.Name)
+	}
+	return names
+}
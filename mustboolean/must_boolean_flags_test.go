@@ -0,0 +1,101 @@
+package mustboolean_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustboolean"
+)
+
+func TestFlags(t *testing.T) {
+	require.Equal(t, []mustboolean.NamedFlag{
+		{Name: "--json", Value: true},
+		{Name: "--xml", Value: false},
+		{Name: "--yaml", Value: true},
+	}, mustboolean.Flags(map[string]bool{"--yaml": true, "--json": true, "--xml": false}))
+
+	require.Empty(t, mustboolean.Flags(nil))
+}
+
+func TestConflictFlags(t *testing.T) {
+	mustboolean.ConflictFlags()
+	mustboolean.ConflictFlags(mustboolean.Flag("--json", true), mustboolean.Flag("--yaml", false))
+	mustboolean.ConflictFlags(mustboolean.Flags(map[string]bool{"--json": false, "--yaml": false})...)
+
+	require.Panics(t, func() {
+		mustboolean.ConflictFlags(mustboolean.Flag("--json", true), mustboolean.Flag("--yaml", true))
+	})
+
+	require.Panics(t, func() {
+		mustboolean.ConflictFlags(mustboolean.Flags(map[string]bool{"--json": true, "--xml": false, "--yaml": true})...)
+	})
+}
+
+func TestExactlyOneFlags(t *testing.T) {
+	mustboolean.ExactlyOneFlags(mustboolean.Flag("--json", false), mustboolean.Flag("--yaml", true))
+
+	require.Panics(t, func() {
+		mustboolean.ExactlyOneFlags(mustboolean.Flag("--json", false), mustboolean.Flag("--yaml", false))
+	})
+
+	require.Panics(t, func() {
+		mustboolean.ExactlyOneFlags(mustboolean.Flag("--json", true), mustboolean.Flag("--yaml", true))
+	})
+}
+
+func TestAtLeastOneFlags(t *testing.T) {
+	mustboolean.AtLeastOneFlags(mustboolean.Flag("--file", true), mustboolean.Flag("--stdin", true))
+
+	require.Panics(t, func() {
+		mustboolean.AtLeastOneFlags(mustboolean.Flag("--file", false), mustboolean.Flag("--stdin", false))
+	})
+}
+
+func TestAllTrueFlags(t *testing.T) {
+	mustboolean.AllTrueFlags(mustboolean.Flag("--user", true), mustboolean.Flag("--password", true))
+
+	require.Panics(t, func() {
+		mustboolean.AllTrueFlags(mustboolean.Flag("--user", true), mustboolean.Flag("--password", false))
+	})
+}
+
+func TestAllFalseFlags(t *testing.T) {
+	mustboolean.AllFalseFlags(mustboolean.Flag("--debug", false), mustboolean.Flag("--trace", false))
+
+	require.Panics(t, func() {
+		mustboolean.AllFalseFlags(mustboolean.Flag("--debug", false), mustboolean.Flag("--trace", true))
+	})
+}
+
+func TestAtMostNFlags(t *testing.T) {
+	mustboolean.AtMostNFlags(2, mustboolean.Flag("-a", true), mustboolean.Flag("-b", true), mustboolean.Flag("-c", false))
+
+	require.Panics(t, func() {
+		mustboolean.AtMostNFlags(1, mustboolean.Flag("-a", true), mustboolean.Flag("-b", true), mustboolean.Flag("-c", false))
+	})
+}
+
+func TestImpliesFlags(t *testing.T) {
+	mustboolean.ImpliesFlags(mustboolean.Flag("--tls", true), mustboolean.Flag("--cert", true))
+	mustboolean.ImpliesFlags(mustboolean.Flag("--tls", false), mustboolean.Flag("--cert", false))
+
+	require.Panics(t, func() {
+		mustboolean.ImpliesFlags(mustboolean.Flag("--tls", true), mustboolean.Flag("--cert", false))
+	})
+}
+
+func TestIffFlags(t *testing.T) {
+	mustboolean.IffFlags(mustboolean.Flag("--cert", true), mustboolean.Flag("--key", true))
+
+	require.Panics(t, func() {
+		mustboolean.IffFlags(mustboolean.Flag("--cert", true), mustboolean.Flag("--key", false))
+	})
+}
+
+func TestXorFlags(t *testing.T) {
+	mustboolean.XorFlags(mustboolean.Flag("--file", true), mustboolean.Flag("--stdin", false))
+
+	require.Panics(t, func() {
+		mustboolean.XorFlags(mustboolean.Flag("--file", true), mustboolean.Flag("--stdin", true))
+	})
+}
@@ -51,3 +51,101 @@ func TestConflict(t *testing.T) {
 		mustboolean.Conflict(true, true, true)
 	})
 }
+
+func TestExactlyOne(t *testing.T) {
+	mustboolean.ExactlyOne(true)
+	mustboolean.ExactlyOne(false, true, false)
+
+	require.Panics(t, func() {
+		mustboolean.ExactlyOne()
+	})
+
+	require.Panics(t, func() {
+		mustboolean.ExactlyOne(false, false)
+	})
+
+	require.Panics(t, func() {
+		mustboolean.ExactlyOne(true, false, true)
+	})
+}
+
+func TestAtLeastOne(t *testing.T) {
+	mustboolean.AtLeastOne(true)
+	mustboolean.AtLeastOne(false, true, true)
+
+	require.Panics(t, func() {
+		mustboolean.AtLeastOne()
+	})
+
+	require.Panics(t, func() {
+		mustboolean.AtLeastOne(false, false)
+	})
+}
+
+func TestAllTrue(t *testing.T) {
+	mustboolean.AllTrue()
+	mustboolean.AllTrue(true, true)
+
+	require.Panics(t, func() {
+		mustboolean.AllTrue(true, false)
+	})
+}
+
+func TestAllFalse(t *testing.T) {
+	mustboolean.AllFalse()
+	mustboolean.AllFalse(false, false)
+
+	require.Panics(t, func() {
+		mustboolean.AllFalse(false, true)
+	})
+}
+
+func TestAtMostN(t *testing.T) {
+	mustboolean.AtMostN(0)
+	mustboolean.AtMostN(0, false, false)
+	mustboolean.AtMostN(2, true, false, true)
+
+	require.Panics(t, func() {
+		mustboolean.AtMostN(0, false, true)
+	})
+
+	require.Panics(t, func() {
+		mustboolean.AtMostN(2, true, true, true)
+	})
+}
+
+func TestImplies(t *testing.T) {
+	mustboolean.Implies(true, true)
+	mustboolean.Implies(false, true)
+	mustboolean.Implies(false, false)
+
+	require.Panics(t, func() {
+		mustboolean.Implies(true, false)
+	})
+}
+
+func TestIff(t *testing.T) {
+	mustboolean.Iff(true, true)
+	mustboolean.Iff(false, false)
+
+	require.Panics(t, func() {
+		mustboolean.Iff(true, false)
+	})
+
+	require.Panics(t, func() {
+		mustboolean.Iff(false, true)
+	})
+}
+
+func TestXor(t *testing.T) {
+	mustboolean.Xor(true, false)
+	mustboolean.Xor(false, true)
+
+	require.Panics(t, func() {
+		mustboolean.Xor(true, true)
+	})
+
+	require.Panics(t, func() {
+		mustboolean.Xor(false, false)
+	})
+}
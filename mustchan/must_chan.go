@@ -0,0 +1,165 @@
+// Package mustchan provides channel assertion utilities with timeouts and panic-on-failure semantics
+// Implements receiving, sending, closing and draining checks bounded by timeouts, plus length checks
+// Supports any element type through generics, fit to check concurrent pipelines with local goroutines
+// Integrates with zap structured logging, logging channel length/capacity and elapsed time when assertions are not met
+//
+// mustchan 提供带超时的通道断言工具，带 panic-on-failure 语义
+// 实现受超时限制的接收、发送、关闭和排空检查，以及长度检查
+// 通过泛型支持任意元素类型，适合配合本地 goroutine 检查并发流水线
+// 与 zap 结构化日志集成，当断言不满足时记录通道长度/容量和耗时
+package mustchan
+
+import (
+	"time"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// Receive receives a value from the channel within the timeout. Panics if the timeout expires or the channel is closed.
+// Receive 在超时时间内从通道接收一个值。如果超时或通道已关闭，则触发 panic。
+func Receive[T any](ch <-chan T, timeout time.Duration) T {
+	start := time.Now()
+	v, ok, expired := receive(ch, timeout)
+	if expired {
+		zaplog.ZAPS.Skip1.LOG.Panic("RECEIVE TIMEOUT(SHOULD RECEIVE)", zap.Int("len", len(ch)), zap.Int("cap", cap(ch)), zap.Duration("elapsed", time.Since(start)), zap.Duration("timeout", timeout))
+	}
+	if !ok {
+		zaplog.ZAPS.Skip1.LOG.Panic("CHANNEL CLOSED(SHOULD RECEIVE)", zap.Int("len", len(ch)), zap.Int("cap", cap(ch)), zap.Duration("elapsed", time.Since(start)))
+	}
+	return v
+}
+
+// receive receives from the channel within the timeout, taking a value (or the close) that is already waiting before starting the timer,
+// so a zero timeout checks the channel instead of racing the expired timer in select.
+//
+// receive 在超时时间内从通道接收，在启动计时器之前先取已就绪的值（或关闭状态），
+// 使零超时检查通道本身，而不是在 select 中与已到期的计时器竞争。
+func receive[T any](ch <-chan T, timeout time.Duration) (v T, ok bool, expired bool) {
+	select {
+	case v, ok = <-ch:
+		return v, ok, false
+	default:
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case v, ok = <-ch:
+		return v, ok, false
+	case <-timer.C:
+		return v, false, true
+	}
+}
+
+// Send sends the value into the channel within the timeout. Panics if the timeout expires or the channel is closed.
+// Send 在超时时间内将值发送到通道。如果超时或通道已关闭，则触发 panic。
+func Send[T any](ch chan<- T, v T, timeout time.Duration) {
+	start := time.Now()
+	sent, closed := trySend(ch, v, timeout)
+	if closed {
+		zaplog.ZAPS.Skip1.LOG.Panic("CHANNEL CLOSED(SHOULD SEND)", zap.Int("len", len(ch)), zap.Int("cap", cap(ch)), zap.Duration("elapsed", time.Since(start)))
+	}
+	if !sent {
+		zaplog.ZAPS.Skip1.LOG.Panic("SEND TIMEOUT(SHOULD SEND)", zap.Int("len", len(ch)), zap.Int("cap", cap(ch)), zap.Duration("elapsed", time.Since(start)), zap.Duration("timeout", timeout))
+	}
+}
+
+// trySend sends the value within the timeout, trying a ready receiver before starting the timer, and converts the panic of sending on a closed channel into closed=true
+// trySend 在超时时间内发送值，在启动计时器之前先尝试已就绪的接收方，并将向已关闭通道发送时的 panic 转换为 closed=true
+func trySend[T any](ch chan<- T, v T, timeout time.Duration) (sent bool, closed bool) {
+	defer func() {
+		if recover() != nil {
+			sent, closed = false, true
+		}
+	}()
+	select {
+	case ch <- v:
+		return true, false
+	default:
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case ch <- v:
+		return true, false
+	case <-timer.C:
+		return false, false
+	}
+}
+
+// Closed expects the channel to be closed within the timeout with no value left. Panics if a value is received or the timeout expires.
+// Closed 期望通道在超时时间内关闭且没有剩余的值。如果接收到值或超时，则触发 panic。
+func Closed[T any](ch <-chan T, timeout time.Duration) {
+	start := time.Now()
+	v, ok, expired := receive(ch, timeout)
+	if expired {
+		zaplog.ZAPS.Skip1.LOG.Panic("CHANNEL NOT CLOSED(SHOULD BE CLOSED)", zap.Int("len", len(ch)), zap.Int("cap", cap(ch)), zap.Duration("elapsed", time.Since(start)), zap.Duration("timeout", timeout))
+	}
+	if ok {
+		zaplog.ZAPS.Skip1.LOG.Panic("CHANNEL HAS VALUE(SHOULD BE CLOSED)", utils.Any("value", v), zap.Int("len", len(ch)), zap.Int("cap", cap(ch)), zap.Duration("elapsed", time.Since(start)))
+	}
+}
+
+// Empty expects the channel to have no buffered value. Panics if the length is not zero.
+// Empty 期望通道中没有缓冲的值。如果长度不为零，则触发 panic。
+func Empty[T any](ch <-chan T) {
+	if len(ch) != 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("CHANNEL NOT EMPTY(SHOULD BE EMPTY)", zap.Int("len", len(ch)), zap.Int("cap", cap(ch)))
+	}
+}
+
+// Len expects the channel to have n buffered values. Panics if the length is not n.
+// Len 期望通道中有 n 个缓冲的值。如果长度不是 n，则触发 panic。
+func Len[T any](ch <-chan T, n int) {
+	if len(ch) != n {
+		zaplog.ZAPS.Skip1.LOG.Panic("CHANNEL LENGTH MISMATCH(SHOULD BE N)", zap.Int("len", len(ch)), zap.Int("n", n), zap.Int("cap", cap(ch)))
+	}
+}
+
+// Drain receives each value until the channel is closed, within the timeout in total. Panics if the timeout expires before closing.
+// The values buffered when the timeout expires still count, so a zero timeout drains a channel that is already closed.
+//
+// Drain 接收所有值直到通道关闭，总耗时限制在超时时间内。如果在关闭前超时，则触发 panic。
+// 超时到期时已缓冲的值仍然计入，因此零超时可以排空已经关闭的通道。
+func Drain[T any](ch <-chan T, timeout time.Duration) []T {
+	start := time.Now()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	var res []T
+	left := -1 // count of values buffered when the timeout expired, -1 before it expires
+	for {
+		if left < 0 {
+			select {
+			case <-timer.C:
+				left = len(ch)
+			default:
+			}
+		}
+		if left >= 0 {
+			// after the timeout only the values buffered by then and the close count, a producer sending ever after cannot hold Drain
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					return res
+				}
+				res = append(res, v) // a late value is kept too, so "received" counts it before panicking
+				if left > 0 {
+					left--
+					continue
+				}
+			default:
+			}
+			zaplog.ZAPS.Skip1.LOG.Panic("DRAIN TIMEOUT(SHOULD BE CLOSED)", zap.Int("received", len(res)), zap.Int("len", len(ch)), zap.Int("cap", cap(ch)), zap.Duration("elapsed", time.Since(start)), zap.Duration("timeout", timeout))
+		}
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return res
+			}
+			res = append(res, v)
+		case <-timer.C:
+			left = len(ch)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+// Package mustchan_test provides comprehensive testing of mustchan assertion package
+// Tests include receiving, sending, closing, draining and length checks driven by local goroutines
+// Checks each assertion functions with both success and failure cases
+//
+// mustchan_test 为 mustchan 断言包提供全面的测试
+// 测试涵盖由本地 goroutine 驱动的接收、发送、关闭、排空和长度检查
+// 使用成功和失败案例验证所有断言函数
+package mustchan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/internal/tests"
+	"github.com/yyle88/must/mustchan"
+)
+
+const timeout = time.Second
+
+const short = 10 * time.Millisecond
+
+// TestReceive tests receiving with timeout
+// Validates Receive returns the value sent by a goroutine and panics on timeout or closed channel
+//
+// TestReceive 测试带超时的接收
+// 验证 Receive 返回 goroutine 发送的值，在超时或通道关闭时 panic
+func TestReceive(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		ch <- 42
+	}()
+	require.Equal(t, 42, mustchan.Receive(ch, timeout))
+
+	require.Panics(t, func() {
+		mustchan.Receive(ch, short)
+	})
+
+	close(ch)
+	require.Panics(t, func() {
+		mustchan.Receive(ch, timeout)
+	})
+}
+
+// TestSend tests sending with timeout
+// Validates Send delivers the value to a goroutine and panics on timeout or closed channel
+//
+// TestSend 测试带超时的发送
+// 验证 Send 将值发送给 goroutine，在超时或通道关闭时 panic
+func TestSend(t *testing.T) {
+	ch := make(chan string)
+	done := make(chan string)
+	go func() {
+		done <- <-ch
+	}()
+	mustchan.Send(ch, "abc", timeout)
+	require.Equal(t, "abc", mustchan.Receive(done, timeout))
+
+	require.Panics(t, func() {
+		mustchan.Send(ch, "xyz", short)
+	})
+
+	buffered := make(chan string, 1)
+	mustchan.Send(buffered, "abc", short)
+	close(buffered)
+	require.Panics(t, func() {
+		mustchan.Send(buffered, "xyz", timeout)
+	})
+}
+
+// TestClosed tests channel closing with timeout
+// Validates Closed passes when a goroutine closes the channel and panics when a value is left or the timeout expires
+//
+// TestClosed 测试带超时的通道关闭
+// 验证 Closed 在 goroutine 关闭通道时通过，在有剩余值或超时时 panic
+func TestClosed(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		close(ch)
+	}()
+	mustchan.Closed(ch, timeout)
+
+	require.Panics(t, func() {
+		mustchan.Closed(make(chan int), short)
+	})
+
+	buffered := make(chan int, 1)
+	buffered <- 1
+	close(buffered)
+	require.Panics(t, func() {
+		mustchan.Closed(buffered, timeout)
+	})
+}
+
+// TestEmpty tests channel emptiness assertion
+// Validates Empty passes with no buffered value and panics when values are buffered
+//
+// TestEmpty 测试通道为空断言
+// 验证 Empty 在没有缓冲值时通过，在有缓冲值时 panic
+func TestEmpty(t *testing.T) {
+	ch := make(chan int, 2)
+	mustchan.Empty(ch)
+	mustchan.Empty(make(chan int))
+
+	ch <- 1
+	require.Panics(t, func() {
+		mustchan.Empty(ch)
+	})
+}
+
+// TestLen tests channel length assertion
+// Validates Len passes when the buffered count matches and panics when it differs
+//
+// TestLen 测试通道长度断言
+// 验证 Len 在缓冲数量相同时通过，在数量不同时 panic
+func TestLen(t *testing.T) {
+	ch := make(chan int, 3)
+	mustchan.Len(ch, 0)
+	ch <- 1
+	ch <- 2
+	mustchan.Len(ch, 2)
+
+	require.Panics(t, func() {
+		mustchan.Len(ch, 3)
+	})
+}
+
+// TestDrain tests draining with timeout
+// Validates Drain returns each value until a goroutine closes the channel and panics when the channel is not closed in time
+//
+// TestDrain 测试带超时的排空
+// 验证 Drain 返回所有值直到 goroutine 关闭通道，在通道未及时关闭时 panic
+func TestDrain(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+		close(ch)
+	}()
+	require.Equal(t, []int{1, 2, 3}, mustchan.Drain(ch, timeout))
+
+	closed := make(chan int)
+	close(closed)
+	require.Empty(t, mustchan.Drain(closed, timeout))
+
+	open := make(chan int, 2)
+	open <- 1
+	require.Panics(t, func() {
+		mustchan.Drain(open, short)
+	})
+
+	endless := make(chan int)
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case endless <- 1:
+			case <-stop:
+				return
+			}
+		}
+	}()
+	require.Panics(t, func() {
+		mustchan.Drain(endless, short)
+	})
+}
+
+// TestDrain_Received tests the received count logged when Drain times out
+// Validates each value taken from the channel is counted, including a value arriving after the timeout
+//
+// TestDrain_Received 测试 Drain 超时时记录的接收数量
+// 验证从通道取出的每个值都被计入，包括超时后到达的值
+func TestDrain_Received(t *testing.T) {
+	logs := tests.ObserveLogs(t)
+	for range 100 {
+		ch := make(chan int, 1)
+		ch <- 1
+		sent := make(chan struct{})
+		go func() {
+			ch <- 2 // blocks until Drain takes the first value
+			close(sent)
+		}()
+		time.Sleep(time.Millisecond)
+
+		require.Panics(t, func() {
+			mustchan.Drain(ch, 0)
+		})
+		received := int(logs.TakeAll()[0].ContextMap()["received"].(int64))
+		<-sent
+		require.Equal(t, 2-received, len(ch)) // the values not counted are still in the channel
+	}
+}
+
+// TestZeroTimeout tests the checks with a zero timeout
+// Validates a value or close that is already waiting always wins over the expired timer, and nothing waiting panics
+//
+// TestZeroTimeout 测试零超时的检查
+// 验证已就绪的值或关闭状态总是优先于已到期的计时器，没有就绪时 panic
+func TestZeroTimeout(t *testing.T) {
+	for range 100 {
+		ch := make(chan int, 2)
+		mustchan.Send(ch, 1, 0)
+		mustchan.Send(ch, 2, 0)
+		require.Equal(t, 1, mustchan.Receive(ch, 0))
+		close(ch)
+		require.Equal(t, []int{2}, mustchan.Drain(ch, 0))
+		mustchan.Closed(ch, 0)
+	}
+
+	require.Panics(t, func() {
+		mustchan.Receive(make(chan int), 0)
+	})
+
+	require.Panics(t, func() {
+		mustchan.Send(make(chan int), 1, 0)
+	})
+
+	require.Panics(t, func() {
+		mustchan.Closed(make(chan int), 0)
+	})
+
+	require.Panics(t, func() {
+		mustchan.Drain(make(chan int, 1), 0)
+	})
+}
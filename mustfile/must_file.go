@@ -0,0 +1,125 @@
+// Package mustfile provides filesystem assertion utilities and must-style file operations with panic-on-failure semantics
+// Implements existence, type and mode checks, plus reading, writing, directory creation, path resolution and globbing
+// Supports both the OS filesystem through paths and any fs.FS (such as fstest.MapFS) through the FS-prefixed functions
+// Integrates with zap structured logging, logging the path and the OS error when assertions are not met
+//
+// mustfile 提供文件系统断言工具和 must 风格的文件操作，带 panic-on-failure 语义
+// 实现存在性、类型和权限检查，以及读取、写入、创建目录、路径解析和通配匹配
+// 通过路径支持操作系统文件系统，通过 FS 前缀的函数支持任意 fs.FS（例如 fstest.MapFS）
+// 与 zap 结构化日志集成，当断言不满足时记录路径和系统错误
+package mustfile
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// Exists expects the path to exist. Panics if stat fails, logging the path and the error.
+// Exists 期望路径存在。如果 stat 失败则触发 panic，并记录路径和错误。
+func Exists(path string) {
+	if _, err := os.Stat(path); err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("PATH NOT EXISTS(SHOULD EXIST)", zap.String("path", path), zap.Error(err))
+	}
+}
+
+// NotExists expects the path to not exist. Panics if it exists or if stat fails with another error.
+// NotExists 期望路径不存在。如果路径存在或 stat 因其他错误失败则触发 panic。
+func NotExists(path string) {
+	info, err := os.Stat(path)
+	if err == nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("PATH EXISTS(SHOULD NOT EXIST)", zap.String("path", path), zap.Stringer("mode", info.Mode()))
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STAT FAILED(SHOULD BE NOT EXIST)", zap.String("path", path), zap.Error(err))
+	}
+}
+
+// IsDir expects the path to be a directory. Panics if stat fails or the path is not a directory.
+// IsDir 期望路径是目录。如果 stat 失败或路径不是目录则触发 panic。
+func IsDir(path string) {
+	info, err := os.Stat(path)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("STAT FAILED(SHOULD BE DIR)", zap.String("path", path), zap.Error(err))
+	}
+	if !info.IsDir() {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT DIR(SHOULD BE DIR)", zap.String("path", path), zap.Stringer("mode", info.Mode()))
+	}
+}
+
+// IsFile expects the path to be a regular file. Panics if stat fails or the path is not a regular file.
+// IsFile 期望路径是普通文件。如果 stat 失败或路径不是普通文件则触发 panic。
+func IsFile(path string) {
+	info, err := os.Stat(path)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("STAT FAILED(SHOULD BE FILE)", zap.String("path", path), zap.Error(err))
+	}
+	if !info.Mode().IsRegular() {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT FILE(SHOULD BE FILE)", zap.String("path", path), zap.Stringer("mode", info.Mode()))
+	}
+}
+
+// Mode expects the permission bits of the path to match perm. Panics if stat fails or the permission bits differ.
+// Mode 期望路径的权限位与 perm 相同。如果 stat 失败或权限位不同则触发 panic。
+func Mode(path string, perm fs.FileMode) {
+	info, err := os.Stat(path)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("STAT FAILED(SHOULD HAVE MODE)", zap.String("path", path), zap.Error(err))
+	}
+	if info.Mode().Perm() != perm.Perm() {
+		zaplog.ZAPS.Skip1.LOG.Panic("MODE MISMATCH(SHOULD BE SAME)", zap.String("path", path), zap.Stringer("mode", info.Mode().Perm()), zap.Stringer("perm", perm.Perm()))
+	}
+}
+
+// ReadFile reads the file and returns its content. Panics if reading fails, logging the path and the error.
+// ReadFile 读取文件并返回内容。如果读取失败则触发 panic，并记录路径和错误。
+func ReadFile(path string) []byte {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("READ FILE FAILED(SHOULD BE READABLE)", zap.String("path", path), zap.Error(err))
+	}
+	return data
+}
+
+// WriteFile writes the data into the file with perm. Panics if writing fails, logging the path and the error.
+// WriteFile 使用 perm 将数据写入文件。如果写入失败则触发 panic，并记录路径和错误。
+func WriteFile(path string, data []byte, perm fs.FileMode) {
+	if err := os.WriteFile(path, data, perm); err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("WRITE FILE FAILED(SHOULD BE WRITABLE)", zap.String("path", path), zap.Int("len", len(data)), zap.Error(err))
+	}
+}
+
+// MkdirAll creates the directory and its parents with perm. Panics if creation fails, logging the path and the error.
+// MkdirAll 使用 perm 创建目录及其父目录。如果创建失败则触发 panic，并记录路径和错误。
+func MkdirAll(path string, perm fs.FileMode) {
+	if err := os.MkdirAll(path, perm); err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("MKDIR FAILED(SHOULD BE CREATED)", zap.String("path", path), zap.Error(err))
+	}
+}
+
+// Abs returns the absolute form of the path. Panics if resolving fails, logging the path and the error.
+// Abs 返回路径的绝对形式。如果解析失败则触发 panic，并记录路径和错误。
+func Abs(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("ABS PATH FAILED(SHOULD BE RESOLVED)", zap.String("path", path), zap.Error(err))
+	}
+	return abs
+}
+
+// Glob returns the paths matching the pattern. Panics if the pattern is malformed or nothing matches.
+// Glob 返回匹配模式的路径。如果模式格式错误或没有匹配项则触发 panic。
+func Glob(pattern string) []string {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("GLOB FAILED(SHOULD BE VALID PATTERN)", zap.String("pattern", pattern), zap.Error(err))
+	}
+	if len(matches) == 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("GLOB NO MATCH(SHOULD MATCH)", zap.String("pattern", pattern))
+	}
+	return matches
+}
@@ -0,0 +1,88 @@
+package mustfile
+
+import (
+	"errors"
+	"io/fs"
+
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// FSExists expects the name to exist in fsys. Panics if stat fails, logging the name and the error.
+// FSExists 期望名称在 fsys 中存在。如果 stat 失败则触发 panic，并记录名称和错误。
+func FSExists(fsys fs.FS, name string) {
+	if _, err := fs.Stat(fsys, name); err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("PATH NOT EXISTS(SHOULD EXIST)", zap.String("path", name), zap.Error(err))
+	}
+}
+
+// FSNotExists expects the name to not exist in fsys. Panics if it exists or if stat fails with another error.
+// FSNotExists 期望名称在 fsys 中不存在。如果存在或 stat 因其他错误失败则触发 panic。
+func FSNotExists(fsys fs.FS, name string) {
+	info, err := fs.Stat(fsys, name)
+	if err == nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("PATH EXISTS(SHOULD NOT EXIST)", zap.String("path", name), zap.Stringer("mode", info.Mode()))
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STAT FAILED(SHOULD BE NOT EXIST)", zap.String("path", name), zap.Error(err))
+	}
+}
+
+// FSIsDir expects the name to be a directory in fsys. Panics if stat fails or the name is not a directory.
+// FSIsDir 期望名称在 fsys 中是目录。如果 stat 失败或不是目录则触发 panic。
+func FSIsDir(fsys fs.FS, name string) {
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("STAT FAILED(SHOULD BE DIR)", zap.String("path", name), zap.Error(err))
+	}
+	if !info.IsDir() {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT DIR(SHOULD BE DIR)", zap.String("path", name), zap.Stringer("mode", info.Mode()))
+	}
+}
+
+// FSIsFile expects the name to be a regular file in fsys. Panics if stat fails or the name is not a regular file.
+// FSIsFile 期望名称在 fsys 中是普通文件。如果 stat 失败或不是普通文件则触发 panic。
+func FSIsFile(fsys fs.FS, name string) {
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("STAT FAILED(SHOULD BE FILE)", zap.String("path", name), zap.Error(err))
+	}
+	if !info.Mode().IsRegular() {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT FILE(SHOULD BE FILE)", zap.String("path", name), zap.Stringer("mode", info.Mode()))
+	}
+}
+
+// FSMode expects the permission bits of the name in fsys to match perm. Panics if stat fails or the permission bits differ.
+// FSMode 期望名称在 fsys 中的权限位与 perm 相同。如果 stat 失败或权限位不同则触发 panic。
+func FSMode(fsys fs.FS, name string, perm fs.FileMode) {
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("STAT FAILED(SHOULD HAVE MODE)", zap.String("path", name), zap.Error(err))
+	}
+	if info.Mode().Perm() != perm.Perm() {
+		zaplog.ZAPS.Skip1.LOG.Panic("MODE MISMATCH(SHOULD BE SAME)", zap.String("path", name), zap.Stringer("mode", info.Mode().Perm()), zap.Stringer("perm", perm.Perm()))
+	}
+}
+
+// FSReadFile reads the name from fsys and returns its content. Panics if reading fails, logging the name and the error.
+// FSReadFile 从 fsys 读取名称对应的文件并返回内容。如果读取失败则触发 panic，并记录名称和错误。
+func FSReadFile(fsys fs.FS, name string) []byte {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("READ FILE FAILED(SHOULD BE READABLE)", zap.String("path", name), zap.Error(err))
+	}
+	return data
+}
+
+// FSGlob returns the names in fsys matching the pattern. Panics if the pattern is malformed or nothing matches.
+// FSGlob 返回 fsys 中匹配模式的名称。如果模式格式错误或没有匹配项则触发 panic。
+func FSGlob(fsys fs.FS, pattern string) []string {
+	matches, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("GLOB FAILED(SHOULD BE VALID PATTERN)", zap.String("pattern", pattern), zap.Error(err))
+	}
+	if len(matches) == 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("GLOB NO MATCH(SHOULD MATCH)", zap.String("pattern", pattern))
+	}
+	return matches
+}
@@ -0,0 +1,134 @@
+package mustfile_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustfile"
+)
+
+func newMapFS() fstest.MapFS {
+	return fstest.MapFS{
+		"conf/app.yaml":  {Data: []byte("name: app"), Mode: 0640},
+		"conf/db.yaml":   {Data: []byte("host: db"), Mode: 0600},
+		"data/users.csv": {Data: []byte("id,name"), Mode: 0644},
+	}
+}
+
+// TestFSExists tests path existence assertion on fs.FS
+// Validates FSExists passes with existing files and directories and panics with missing names
+//
+// TestFSExists 测试 fs.FS 上的路径存在断言
+// 验证 FSExists 在文件和目录存在时通过，在名称缺失时 panic
+func TestFSExists(t *testing.T) {
+	fsys := newMapFS()
+	mustfile.FSExists(fsys, "conf")
+	mustfile.FSExists(fsys, "conf/app.yaml")
+
+	require.Panics(t, func() {
+		mustfile.FSExists(fsys, "conf/missing.yaml")
+	})
+}
+
+// TestFSNotExists tests path absence assertion on fs.FS
+// Validates FSNotExists passes with missing names and panics with existing ones
+//
+// TestFSNotExists 测试 fs.FS 上的路径不存在断言
+// 验证 FSNotExists 在名称缺失时通过，在名称存在时 panic
+func TestFSNotExists(t *testing.T) {
+	fsys := newMapFS()
+	mustfile.FSNotExists(fsys, "conf/missing.yaml")
+
+	require.Panics(t, func() {
+		mustfile.FSNotExists(fsys, "conf/app.yaml")
+	})
+}
+
+// TestFSIsDir tests directory assertion on fs.FS
+// Validates FSIsDir passes with directories and panics with files or missing names
+//
+// TestFSIsDir 测试 fs.FS 上的目录断言
+// 验证 FSIsDir 在目录时通过，在文件或名称缺失时 panic
+func TestFSIsDir(t *testing.T) {
+	fsys := newMapFS()
+	mustfile.FSIsDir(fsys, "conf")
+	mustfile.FSIsDir(fsys, ".")
+
+	require.Panics(t, func() {
+		mustfile.FSIsDir(fsys, "conf/app.yaml")
+	})
+
+	require.Panics(t, func() {
+		mustfile.FSIsDir(fsys, "missing")
+	})
+}
+
+// TestFSIsFile tests regular file assertion on fs.FS
+// Validates FSIsFile passes with regular files and panics with directories or missing names
+//
+// TestFSIsFile 测试 fs.FS 上的普通文件断言
+// 验证 FSIsFile 在普通文件时通过，在目录或名称缺失时 panic
+func TestFSIsFile(t *testing.T) {
+	fsys := newMapFS()
+	mustfile.FSIsFile(fsys, "conf/app.yaml")
+
+	require.Panics(t, func() {
+		mustfile.FSIsFile(fsys, "conf")
+	})
+
+	require.Panics(t, func() {
+		mustfile.FSIsFile(fsys, "missing.yaml")
+	})
+}
+
+// TestFSMode tests permission bits assertion on fs.FS
+// Validates FSMode passes when the permission bits match and panics when they differ
+//
+// TestFSMode 测试 fs.FS 上的权限位断言
+// 验证 FSMode 在权限位相同时通过，在权限位不同时 panic
+func TestFSMode(t *testing.T) {
+	fsys := newMapFS()
+	mustfile.FSMode(fsys, "conf/app.yaml", 0640)
+	mustfile.FSMode(fsys, "conf/db.yaml", 0600)
+
+	require.Panics(t, func() {
+		mustfile.FSMode(fsys, "conf/app.yaml", 0644)
+	})
+
+	require.Panics(t, func() {
+		mustfile.FSMode(fsys, "missing.yaml", 0644)
+	})
+}
+
+// TestFSReadFile tests must-style file reading on fs.FS
+// Validates FSReadFile returns the content and panics with missing names
+//
+// TestFSReadFile 测试 fs.FS 上 must 风格的文件读取
+// 验证 FSReadFile 返回文件内容，在名称缺失时 panic
+func TestFSReadFile(t *testing.T) {
+	fsys := newMapFS()
+	require.Equal(t, []byte("host: db"), mustfile.FSReadFile(fsys, "conf/db.yaml"))
+
+	require.Panics(t, func() {
+		mustfile.FSReadFile(fsys, "conf/missing.yaml")
+	})
+}
+
+// TestFSGlob tests must-style globbing on fs.FS
+// Validates FSGlob returns the matches and panics with malformed patterns or empty results
+//
+// TestFSGlob 测试 fs.FS 上 must 风格的通配匹配
+// 验证 FSGlob 返回匹配项，在模式格式错误或无匹配时 panic
+func TestFSGlob(t *testing.T) {
+	fsys := newMapFS()
+	require.Equal(t, []string{"conf/app.yaml", "conf/db.yaml"}, mustfile.FSGlob(fsys, "conf/*.yaml"))
+
+	require.Panics(t, func() {
+		mustfile.FSGlob(fsys, "conf/*.json")
+	})
+
+	require.Panics(t, func() {
+		mustfile.FSGlob(fsys, "[")
+	})
+}
@@ -0,0 +1,208 @@
+// Package mustfile_test provides comprehensive testing of mustfile assertion package
+// Tests include existence, type and mode checks, and the must-style file operations on the OS filesystem
+// Checks each assertion functions with both success and failure cases
+//
+// mustfile_test 为 mustfile 断言包提供全面的测试
+// 测试涵盖操作系统文件系统上的存在性、类型和权限检查以及 must 风格的文件操作
+// 使用成功和失败案例验证所有断言函数
+package mustfile_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustfile"
+)
+
+// TestExists tests path existence assertion
+// Validates Exists passes with existing files and directories and panics with missing paths
+//
+// TestExists 测试路径存在断言
+// 验证 Exists 在文件和目录存在时通过，在路径缺失时 panic
+func TestExists(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "a.txt")
+	mustfile.WriteFile(path, []byte("abc"), 0644)
+
+	mustfile.Exists(root)
+	mustfile.Exists(path)
+
+	require.Panics(t, func() {
+		mustfile.Exists(filepath.Join(root, "missing.txt"))
+	})
+}
+
+// TestNotExists tests path absence assertion
+// Validates NotExists passes with missing paths and panics with existing ones or unexpected stat errors
+//
+// TestNotExists 测试路径不存在断言
+// 验证 NotExists 在路径缺失时通过，在路径存在或 stat 出现其他错误时 panic
+func TestNotExists(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "a.txt")
+	mustfile.NotExists(path)
+
+	mustfile.WriteFile(path, []byte("abc"), 0644)
+	require.Panics(t, func() {
+		mustfile.NotExists(path)
+	})
+
+	require.Panics(t, func() {
+		mustfile.NotExists(filepath.Join(path, "child")) // parent is a file: ENOTDIR
+	})
+}
+
+// TestIsDir tests directory assertion
+// Validates IsDir passes with directories and panics with files or missing paths
+//
+// TestIsDir 测试目录断言
+// 验证 IsDir 在目录时通过，在文件或路径缺失时 panic
+func TestIsDir(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "a.txt")
+	mustfile.WriteFile(path, []byte("abc"), 0644)
+
+	mustfile.IsDir(root)
+
+	require.Panics(t, func() {
+		mustfile.IsDir(path)
+	})
+
+	require.Panics(t, func() {
+		mustfile.IsDir(filepath.Join(root, "missing"))
+	})
+}
+
+// TestIsFile tests regular file assertion
+// Validates IsFile passes with regular files and panics with directories or missing paths
+//
+// TestIsFile 测试普通文件断言
+// 验证 IsFile 在普通文件时通过，在目录或路径缺失时 panic
+func TestIsFile(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "a.txt")
+	mustfile.WriteFile(path, []byte("abc"), 0644)
+
+	mustfile.IsFile(path)
+
+	require.Panics(t, func() {
+		mustfile.IsFile(root)
+	})
+
+	require.Panics(t, func() {
+		mustfile.IsFile(filepath.Join(root, "missing.txt"))
+	})
+}
+
+// TestMode tests permission bits assertion
+// Validates Mode passes when the permission bits match and panics when they differ
+//
+// TestMode 测试权限位断言
+// 验证 Mode 在权限位相同时通过，在权限位不同时 panic
+func TestMode(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "a.txt")
+	mustfile.WriteFile(path, []byte("abc"), 0600)
+	require.NoError(t, os.Chmod(path, 0640))
+
+	mustfile.Mode(path, 0640)
+
+	require.Panics(t, func() {
+		mustfile.Mode(path, 0600)
+	})
+
+	require.Panics(t, func() {
+		mustfile.Mode(filepath.Join(root, "missing.txt"), 0640)
+	})
+}
+
+// TestReadFile tests must-style file reading
+// Validates ReadFile returns the content and panics with missing files
+//
+// TestReadFile 测试 must 风格的文件读取
+// 验证 ReadFile 返回文件内容，在文件缺失时 panic
+func TestReadFile(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "a.txt")
+	mustfile.WriteFile(path, []byte("abc"), 0644)
+
+	require.Equal(t, []byte("abc"), mustfile.ReadFile(path))
+
+	require.Panics(t, func() {
+		mustfile.ReadFile(filepath.Join(root, "missing.txt"))
+	})
+}
+
+// TestWriteFile tests must-style file writing
+// Validates WriteFile writes the content and panics when the parent directory is missing
+//
+// TestWriteFile 测试 must 风格的文件写入
+// 验证 WriteFile 写入内容，在父目录缺失时 panic
+func TestWriteFile(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "a.txt")
+	mustfile.WriteFile(path, []byte("abc"), 0644)
+	mustfile.WriteFile(path, []byte("xyz"), 0644)
+	require.Equal(t, []byte("xyz"), mustfile.ReadFile(path))
+
+	require.Panics(t, func() {
+		mustfile.WriteFile(filepath.Join(root, "missing", "a.txt"), []byte("abc"), 0644)
+	})
+}
+
+// TestMkdirAll tests must-style directory creation
+// Validates MkdirAll creates nested directories and panics when a parent is a file
+//
+// TestMkdirAll 测试 must 风格的目录创建
+// 验证 MkdirAll 创建嵌套目录，在父路径是文件时 panic
+func TestMkdirAll(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "a", "b", "c")
+	mustfile.MkdirAll(dir, 0755)
+	mustfile.MkdirAll(dir, 0755)
+	mustfile.IsDir(dir)
+
+	path := filepath.Join(root, "a.txt")
+	mustfile.WriteFile(path, []byte("abc"), 0644)
+	require.Panics(t, func() {
+		mustfile.MkdirAll(filepath.Join(path, "child"), 0755)
+	})
+}
+
+// TestAbs tests must-style path resolution
+// Validates Abs returns an absolute path
+//
+// TestAbs 测试 must 风格的路径解析
+// 验证 Abs 返回绝对路径
+func TestAbs(t *testing.T) {
+	abs := mustfile.Abs("testdata/../a.txt")
+	require.True(t, filepath.IsAbs(abs))
+	require.Equal(t, "a.txt", filepath.Base(abs))
+
+	root := t.TempDir()
+	require.Equal(t, root, mustfile.Abs(root))
+}
+
+// TestGlob tests must-style globbing
+// Validates Glob returns the matches and panics with malformed patterns or empty results
+//
+// TestGlob 测试 must 风格的通配匹配
+// 验证 Glob 返回匹配项，在模式格式错误或无匹配时 panic
+func TestGlob(t *testing.T) {
+	root := t.TempDir()
+	mustfile.WriteFile(filepath.Join(root, "a.txt"), []byte("a"), 0644)
+	mustfile.WriteFile(filepath.Join(root, "b.txt"), []byte("b"), 0644)
+	mustfile.WriteFile(filepath.Join(root, "c.log"), []byte("c"), 0644)
+
+	require.Equal(t, []string{filepath.Join(root, "a.txt"), filepath.Join(root, "b.txt")}, mustfile.Glob(filepath.Join(root, "*.txt")))
+
+	require.Panics(t, func() {
+		mustfile.Glob(filepath.Join(root, "*.json"))
+	})
+
+	require.Panics(t, func() {
+		mustfile.Glob(filepath.Join(root, "[")) // malformed pattern
+	})
+}
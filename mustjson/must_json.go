@@ -0,0 +1,87 @@
+// Package mustjson provides JSON marshal/unmarshal operations and document assertions with panic-on-failure semantics
+// Implements must-style encoding and decoding, strict decoding rejecting unknown fields, and validity checks
+// Supports semantic document equality with a path-based diff, and value extraction through "$.items[0].id" paths
+// Integrates with zap structured logging to provide detailed context when assertions are not met
+//
+// mustjson 提供 JSON 编解码操作和文档断言，带 panic-on-failure 语义
+// 实现 must 风格的编码和解码、拒绝未知字段的严格解码以及有效性检查
+// 支持带路径差异的文档语义相等比较，以及通过 "$.items[0].id" 路径提取值
+// 与 zap 结构化日志集成，当断言不满足时提供详细上下文
+package mustjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// Marshal encodes v into JSON. Panics if encoding fails.
+// Marshal 将 v 编码为 JSON。如果编码失败则触发 panic。
+func Marshal(v any) []byte {
+	data, err := json.Marshal(v)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON MARSHAL FAILED(SHOULD BE MARSHALLED)", zap.String("type", fmt.Sprintf("%T", v)), zap.Error(err))
+	}
+	return data
+}
+
+// MarshalIndent encodes v into indented JSON. Panics if encoding fails.
+// MarshalIndent 将 v 编码为带缩进的 JSON。如果编码失败则触发 panic。
+func MarshalIndent(v any, prefix, indent string) []byte {
+	data, err := json.MarshalIndent(v, prefix, indent)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON MARSHAL FAILED(SHOULD BE MARSHALLED)", zap.String("type", fmt.Sprintf("%T", v)), zap.Error(err))
+	}
+	return data
+}
+
+// Unmarshal decodes the data into a value of type T. Panics if decoding fails.
+// Unmarshal 将数据解码为 T 类型的值。如果解码失败则触发 panic。
+func Unmarshal[T any](data []byte) T {
+	var res T
+	if err := json.Unmarshal(data, &res); err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON UNMARSHAL FAILED(SHOULD BE UNMARSHALLED)", zap.String("type", utils.TypeName[T]()), zap.Int("len", len(data)), zap.Error(err))
+	}
+	return res
+}
+
+// UnmarshalStrict decodes the data into a value of type T, rejecting unknown fields and trailing data. Panics if decoding fails.
+// UnmarshalStrict 将数据解码为 T 类型的值，拒绝未知字段和尾随数据。如果解码失败则触发 panic。
+func UnmarshalStrict[T any](data []byte) T {
+	var res T
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&res); err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON UNMARSHAL FAILED(SHOULD BE UNMARSHALLED)", zap.String("type", utils.TypeName[T]()), zap.Int("len", len(data)), zap.Int64("offset", decoder.InputOffset()), zap.Error(err))
+	}
+	if err := decoder.Decode(&json.RawMessage{}); !errors.Is(err, io.EOF) {
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON TRAILING DATA(SHOULD BE SINGLE VALUE)", zap.String("type", utils.TypeName[T]()), zap.Int("len", len(data)), zap.Int64("offset", decoder.InputOffset()), zap.Error(err))
+	}
+	return res
+}
+
+// Valid expects the data to be valid JSON. Panics if not valid, logging the syntax error offset.
+// Valid 期望数据是有效的 JSON。如果无效则触发 panic，并记录语法错误的偏移量。
+func Valid(data []byte) {
+	if !json.Valid(data) {
+		var v any
+		err := json.Unmarshal(data, &v)
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON NOT VALID(SHOULD BE VALID)", zap.Int("len", len(data)), zap.Int64("offset", syntaxOffset(err)), zap.Error(err))
+	}
+}
+
+// syntaxOffset returns the offset of the syntax error, -1 if err is not a syntax error
+// syntaxOffset 返回语法错误的偏移量，如果不是语法错误则返回 -1
+func syntaxOffset(err error) int64 {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return syntaxErr.Offset
+	}
+	return -1
+}
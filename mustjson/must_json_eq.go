@@ -0,0 +1,73 @@
+package mustjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// JSONEq expects a and b to be semantically equal JSON documents, ignoring key order and whitespace.
+// Panics if either is not valid JSON, or if they differ, logging each difference with its path like "$.items[0].id".
+//
+// JSONEq 期望 a 和 b 是语义相等的 JSON 文档，忽略键的顺序和空白。
+// 如果任一不是有效 JSON 或两者不同则触发 panic，并记录每处差异及其路径，例如 "$.items[0].id"。
+func JSONEq(a, b []byte) {
+	va, err := decodeNumber(a)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON NOT VALID(SHOULD BE VALID)", zap.String("side", "a"), zap.Int("len", len(a)), zap.Int64("offset", syntaxOffset(err)), zap.Error(err))
+	}
+	vb, err := decodeNumber(b)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON NOT VALID(SHOULD BE VALID)", zap.String("side", "b"), zap.Int("len", len(b)), zap.Int64("offset", syntaxOffset(err)), zap.Error(err))
+	}
+	if diffs := utils.DocumentDiff(va, vb, sameScalar); len(diffs) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON NOT EQUAL(SHOULD BE EQUAL)", utils.DiffFields(diffs)...)
+	}
+}
+
+// decodeNumber decodes the data keeping numbers as json.Number, so large integers compare exactly
+// decodeNumber 解码数据并将数字保留为 json.Number，使大整数可以精确比较
+func decodeNumber(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	offset := decoder.InputOffset()
+	if err := decoder.Decode(&json.RawMessage{}); !errors.Is(err, io.EOF) {
+		if err == nil {
+			err = fmt.Errorf("trailing data at offset %d", offset)
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+// sameScalar compares the scalars, comparing numbers by value
+// sameScalar 比较标量，按数值比较数字
+func sameScalar(a, b any) bool {
+	if na, ok := a.(json.Number); ok {
+		nb, ok := b.(json.Number)
+		return ok && sameNumber(na, nb)
+	}
+	return a == b
+}
+
+// sameNumber compares the numbers by value, so 1 and 1.0 match
+// sameNumber 按数值比较数字，使 1 和 1.0 相等
+func sameNumber(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	fa, _, errA := big.ParseFloat(string(a), 10, 256, big.ToNearestEven)
+	fb, _, errB := big.ParseFloat(string(b), 10, 256, big.ToNearestEven)
+	return errA == nil && errB == nil && fa.Cmp(fb) == 0
+}
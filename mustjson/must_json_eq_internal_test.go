@@ -0,0 +1,49 @@
+package mustjson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/internal/utils"
+)
+
+func TestJSONDiff(t *testing.T) {
+	va, err := decodeNumber([]byte(`{"a":1,"b":{"c":[1,2,3]},"d":"x","content-type":"json"}`))
+	require.NoError(t, err)
+	vb, err := decodeNumber([]byte(`{"a":1.0,"b":{"c":[1,5]},"e":null,"content-type":"xml"}`))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		`$.b.c: length 3 != 2`,
+		`$.b.c[1]: 2 != 5`,
+		`$.b.c[2]: only in a: 3`,
+		`$.content-type: "json" != "xml"`,
+		`$.d: only in a: "x"`,
+		`$.e: only in b: null`,
+	}, utils.DocumentDiff(va, vb, sameScalar))
+}
+
+func TestJSONDiffTypeMismatch(t *testing.T) {
+	va, err := decodeNumber([]byte(`{"a":{"b":1}}`))
+	require.NoError(t, err)
+	vb, err := decodeNumber([]byte(`{"a":[1]}`))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{`$.a: {"b":1} != [1]`}, utils.DocumentDiff(va, vb, sameScalar))
+}
+
+func TestParsePath(t *testing.T) {
+	steps, err := parsePath(`$.items[0]["a\"]b"].id`)
+	require.NoError(t, err)
+	require.Equal(t, []pathStep{
+		{key: "items"},
+		{index: 0, isIndex: true},
+		{key: `a"]b`},
+		{key: "id"},
+	}, steps)
+
+	for _, path := range []string{"", "items", "$.", "$..a", "$[", "$[-1]", "$[x]", `$["a]`, "$a"} {
+		_, err := parsePath(path)
+		require.Error(t, err, path)
+	}
+}
@@ -0,0 +1,133 @@
+package mustjson
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// Path extracts the value at the path from the JSON data, like "$.items[0].id" or `$["content-type"]`.
+// Values are decoded as encoding/json does with any, except numbers: objects as map[string]any, arrays as []any, numbers as json.Number.
+// Panics if the data is not valid JSON, the path is malformed, or the path does not exist.
+//
+// Path 从 JSON 数据中提取路径处的值，例如 "$.items[0].id" 或 `$["content-type"]`。
+// 值的解码方式与 encoding/json 解码到 any 时相同（数字除外）：对象为 map[string]any，数组为 []any，数字为 json.Number。
+// 如果数据不是有效 JSON、路径格式错误或路径不存在则触发 panic。
+func Path(data []byte, path string) any {
+	steps, err := parsePath(path)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON PATH INVALID(SHOULD BE VALID)", zap.String("path", path), zap.Error(err))
+	}
+	value, err := decodeNumber(data)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("JSON NOT VALID(SHOULD BE VALID)", zap.String("path", path), zap.Int("len", len(data)), zap.Int64("offset", syntaxOffset(err)), zap.Error(err))
+	}
+	at := "$"
+	for _, step := range steps {
+		switch node := value.(type) {
+		case map[string]any:
+			if step.isIndex {
+				zaplog.ZAPS.Skip1.LOG.Panic("JSON PATH NOT FOUND(SHOULD EXIST)", zap.String("path", path), zap.String("at", at), zap.String("reason", fmt.Sprintf("object indexed by [%d]", step.index)))
+			}
+			sub, exists := node[step.key]
+			if !exists {
+				zaplog.ZAPS.Skip1.LOG.Panic("JSON PATH NOT FOUND(SHOULD EXIST)", zap.String("path", path), zap.String("at", at), zap.String("reason", "missing key "+strconv.Quote(step.key)), zap.Strings("keys", utils.SortedKeys(node)))
+			}
+			value = sub
+			at = utils.JoinKey(at, step.key)
+		case []any:
+			if !step.isIndex {
+				zaplog.ZAPS.Skip1.LOG.Panic("JSON PATH NOT FOUND(SHOULD EXIST)", zap.String("path", path), zap.String("at", at), zap.String("reason", "array accessed by key "+strconv.Quote(step.key)))
+			}
+			if step.index >= len(node) {
+				zaplog.ZAPS.Skip1.LOG.Panic("JSON PATH NOT FOUND(SHOULD EXIST)", zap.String("path", path), zap.String("at", at), zap.String("reason", "index out of range"), zap.Int("index", step.index), zap.Int("len", len(node)))
+			}
+			value = node[step.index]
+			at = utils.JoinIndex(at, step.index)
+		default:
+			zaplog.ZAPS.Skip1.LOG.Panic("JSON PATH NOT FOUND(SHOULD EXIST)", zap.String("path", path), zap.String("at", at), zap.String("reason", fmt.Sprintf("cannot descend into %T", node)))
+		}
+	}
+	return value
+}
+
+// pathStep is one step of a path, either an object key or an array index
+// pathStep 是路径中的一步，可以是对象键或数组索引
+type pathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parsePath splits the path into steps, accepting ".key", "[0]" and `["key"]` after the leading "$"
+// parsePath 将路径拆分为步骤，在开头的 "$" 之后接受 ".key"、"[0]" 和 `["key"]`
+func parsePath(path string) ([]pathStep, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path must start with $")
+	}
+	var steps []pathStep
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key := rest[1 : 1+end]
+			if key == "" {
+				return nil, fmt.Errorf("empty key at offset %d", len(path)-len(rest))
+			}
+			steps = append(steps, pathStep{key: key})
+			rest = rest[1+end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed [ at offset %d", len(path)-len(rest))
+			}
+			inner := rest[1:end]
+			if strings.HasPrefix(inner, `"`) {
+				end = closingQuote(rest)
+				if end < 0 {
+					return nil, fmt.Errorf("unclosed quoted key at offset %d", len(path)-len(rest))
+				}
+				key, err := strconv.Unquote(rest[1:end])
+				if err != nil {
+					return nil, fmt.Errorf("bad quoted key at offset %d: %w", len(path)-len(rest), err)
+				}
+				steps = append(steps, pathStep{key: key})
+			} else {
+				index, err := strconv.Atoi(inner)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("bad index %q at offset %d", inner, len(path)-len(rest))
+				}
+				steps = append(steps, pathStep{index: index, isIndex: true})
+			}
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q at offset %d", rest[0], len(path)-len(rest))
+		}
+	}
+	return steps, nil
+}
+
+// closingQuote returns the offset of the "]" closing a `["key"]` step, -1 if there is none
+// closingQuote 返回结束 `["key"]` 步骤的 "]" 的偏移量，如果没有则返回 -1
+func closingQuote(rest string) int {
+	for idx := 2; idx < len(rest); idx++ {
+		switch rest[idx] {
+		case '\\':
+			idx++
+		case '"':
+			if idx+1 < len(rest) && rest[idx+1] == ']' {
+				return idx + 1
+			}
+			return -1
+		}
+	}
+	return -1
+}
@@ -0,0 +1,187 @@
+// Package mustjson_test provides comprehensive testing of mustjson assertion package
+// Tests include marshal/unmarshal operations, strict decoding, validity, semantic equality and path extraction
+// Checks each assertion functions with both success and failure cases
+//
+// mustjson_test 为 mustjson 断言包提供全面的测试
+// 测试涵盖编解码操作、严格解码、有效性、语义相等和路径提取
+// 使用成功和失败案例验证所有断言函数
+package mustjson_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustjson"
+)
+
+type Item struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// TestMarshal tests must-style JSON encoding
+// Validates Marshal returns compact JSON and panics with unsupported values
+//
+// TestMarshal 测试 must 风格的 JSON 编码
+// 验证 Marshal 返回紧凑的 JSON，在值不受支持时 panic
+func TestMarshal(t *testing.T) {
+	require.Equal(t, `{"id":1,"name":"a"}`, string(mustjson.Marshal(Item{ID: 1, Name: "a"})))
+
+	require.Panics(t, func() {
+		mustjson.Marshal(make(chan int))
+	})
+}
+
+// TestMarshalIndent tests must-style indented JSON encoding
+// Validates MarshalIndent returns indented JSON and panics with unsupported values
+//
+// TestMarshalIndent 测试 must 风格的带缩进 JSON 编码
+// 验证 MarshalIndent 返回带缩进的 JSON，在值不受支持时 panic
+func TestMarshalIndent(t *testing.T) {
+	require.Equal(t, "{\n  \"id\": 1,\n  \"name\": \"a\"\n}", string(mustjson.MarshalIndent(Item{ID: 1, Name: "a"}, "", "  ")))
+
+	require.Panics(t, func() {
+		mustjson.MarshalIndent(func() {}, "", "  ")
+	})
+}
+
+// TestUnmarshal tests must-style JSON decoding
+// Validates Unmarshal returns the typed value, ignores unknown fields, and panics with malformed or mistyped data
+//
+// TestUnmarshal 测试 must 风格的 JSON 解码
+// 验证 Unmarshal 返回指定类型的值，忽略未知字段，在数据格式错误或类型不符时 panic
+func TestUnmarshal(t *testing.T) {
+	require.Equal(t, Item{ID: 1, Name: "a"}, mustjson.Unmarshal[Item]([]byte(`{"id":1,"name":"a","extra":true}`)))
+	require.Equal(t, []int{1, 2}, mustjson.Unmarshal[[]int]([]byte(`[1,2]`)))
+
+	require.Panics(t, func() {
+		mustjson.Unmarshal[Item]([]byte(`{"id":`))
+	})
+
+	require.Panics(t, func() {
+		mustjson.Unmarshal[Item]([]byte(`{"id":"1"}`))
+	})
+}
+
+// TestUnmarshalStrict tests strict JSON decoding
+// Validates UnmarshalStrict returns the typed value and panics with unknown fields or trailing data
+//
+// TestUnmarshalStrict 测试严格的 JSON 解码
+// 验证 UnmarshalStrict 返回指定类型的值，在出现未知字段或尾随数据时 panic
+func TestUnmarshalStrict(t *testing.T) {
+	require.Equal(t, Item{ID: 1, Name: "a"}, mustjson.UnmarshalStrict[Item]([]byte(` {"id":1,"name":"a"} `)))
+
+	require.Panics(t, func() {
+		mustjson.UnmarshalStrict[Item]([]byte(`{"id":1,"extra":true}`))
+	})
+
+	require.Panics(t, func() {
+		mustjson.UnmarshalStrict[Item]([]byte(`{"id":1} {"id":2}`))
+	})
+
+	require.Panics(t, func() {
+		mustjson.UnmarshalStrict[Item]([]byte(`{"id":1}]`))
+	})
+}
+
+// TestValid tests JSON validity assertion
+// Validates Valid passes with well-formed documents and panics with malformed ones
+//
+// TestValid 测试 JSON 有效性断言
+// 验证 Valid 在文档格式正确时通过，在格式错误时 panic
+func TestValid(t *testing.T) {
+	mustjson.Valid([]byte(`{"a":[1,2,{"b":null}]}`))
+	mustjson.Valid([]byte(`"text"`))
+
+	require.Panics(t, func() {
+		mustjson.Valid([]byte(`{"a":[1,2}`))
+	})
+
+	require.Panics(t, func() {
+		mustjson.Valid(nil)
+	})
+}
+
+// TestJSONEq tests semantic JSON equality assertion
+// Validates JSONEq ignores key order, whitespace and number formatting, and panics when documents differ or are malformed
+//
+// TestJSONEq 测试 JSON 语义相等断言
+// 验证 JSONEq 忽略键顺序、空白和数字格式，在文档不同或格式错误时 panic
+func TestJSONEq(t *testing.T) {
+	mustjson.JSONEq([]byte(`{"a":1,"b":[1,2]}`), []byte("{\n  \"b\": [1, 2],\n  \"a\": 1.0\n}"))
+	mustjson.JSONEq([]byte(`{"big":12345678901234567890}`), []byte(`{"big":1.2345678901234567890e19}`))
+
+	require.Panics(t, func() {
+		mustjson.JSONEq([]byte(`{"a":1}`), []byte(`{"a":2}`))
+	})
+
+	require.Panics(t, func() {
+		mustjson.JSONEq([]byte(`{"big":12345678901234567890}`), []byte(`{"big":12345678901234567891}`))
+	})
+
+	require.Panics(t, func() {
+		mustjson.JSONEq([]byte(`[1,2]`), []byte(`[2,1]`))
+	})
+
+	require.Panics(t, func() {
+		mustjson.JSONEq([]byte(`{"a":1}`), []byte(`{"a":1`))
+	})
+
+	require.Panics(t, func() {
+		mustjson.JSONEq([]byte(`{"a":1} {}`), []byte(`{"a":1}`))
+	})
+
+	require.Panics(t, func() {
+		mustjson.JSONEq([]byte(`{"a":1}}`), []byte(`{"a":1}`))
+	})
+
+	require.Panics(t, func() {
+		mustjson.JSONEq([]byte(`[1]`), []byte(`[1]]`))
+	})
+}
+
+// TestPath tests JSON path extraction
+// Validates Path returns the values at object keys and array indexes, and panics with missing or malformed paths
+//
+// TestPath 测试 JSON 路径提取
+// 验证 Path 返回对象键和数组索引处的值，在路径缺失或格式错误时 panic
+func TestPath(t *testing.T) {
+	data := []byte(`{"items":[{"id":7,"tags":["x","y"]}],"content-type":"json","a.b":true,"big":9007199254740993}`)
+
+	require.Equal(t, json.Number("7"), mustjson.Path(data, "$.items[0].id"))
+	require.Equal(t, json.Number("9007199254740993"), mustjson.Path(data, "$.big"))
+	require.Equal(t, "y", mustjson.Path(data, "$.items[0].tags[1]"))
+	require.Equal(t, "json", mustjson.Path(data, `$["content-type"]`))
+	require.Equal(t, true, mustjson.Path(data, `$["a.b"]`))
+	require.Len(t, mustjson.Path(data, "$.items"), 1)
+	require.Len(t, mustjson.Path(data, "$"), 4)
+
+	require.Panics(t, func() {
+		mustjson.Path(data, "$.items[1].id")
+	})
+
+	require.Panics(t, func() {
+		mustjson.Path(data, "$.items[0].name")
+	})
+
+	require.Panics(t, func() {
+		mustjson.Path(data, "$.items.id")
+	})
+
+	require.Panics(t, func() {
+		mustjson.Path(data, "$.items[0].id.value")
+	})
+
+	require.Panics(t, func() {
+		mustjson.Path(data, "items[0]")
+	})
+
+	require.Panics(t, func() {
+		mustjson.Path([]byte(`{"a":`), "$.a")
+	})
+
+	require.Panics(t, func() {
+		mustjson.Path([]byte(`{"a":1}}`), "$.a")
+	})
+}
@@ -10,8 +10,11 @@
 package mustmap
 
 import (
+	"fmt"
 	"maps"
+	"strings"
 
+	"github.com/yyle88/must/internal/utils"
 	"github.com/yyle88/zaplog"
 	"go.uber.org/zap"
 )
@@ -95,7 +98,121 @@ func Len[K comparable, V any](a map[K]V, n int) {
 func Get[K, V comparable](a map[K]V, key K) V {
 	value, exists := a[key]
 	if !exists {
-		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", zap.Any("key", key))
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", utils.Any("key", key))
+	}
+	return value
+}
+
+// HasKey checks if the key exists in the map. If not, it panics.
+// HasKey 检查键是否存在于 map 中，如果不存在，则触发 panic。
+func HasKey[K comparable, V any](a map[K]V, key K) {
+	if _, exists := a[key]; !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", utils.Any("key", key), zap.Int("len", len(a)))
+	}
+}
+
+// NotHasKey checks if the key is absent from the map. If present, it panics.
+// NotHasKey 检查键是否不在 map 中，如果存在，则触发 panic。
+func NotHasKey[K comparable, V any](a map[K]V, key K) {
+	if _, exists := a[key]; exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY IN MAP(SHOULD NOT BE IN)", utils.Any("key", key), zap.Int("len", len(a)))
+	}
+}
+
+// HasKeys checks if each of the keys exists in the map. If any is missing, it panics with the missing keys.
+// HasKeys 检查所有键是否都存在于 map 中，如果有缺失，则触发 panic 并记录缺失的键。
+func HasKeys[K comparable, V any](a map[K]V, keys ...K) {
+	if missing := utils.MissingKeys(a, keys); len(missing) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEYS NOT IN MAP(SHOULD BE IN)", utils.Any("missing", utils.Pick(keys, missing)), zap.Int("len", len(a)))
+	}
+}
+
+// KeysExactly checks if the map keys are exactly the given keys. If not, it panics with the missing and unexpected keys.
+// KeysExactly 检查 map 的键是否恰好是给定的键，如果不是，则触发 panic 并记录缺失和多余的键。
+func KeysExactly[K comparable, V any](a map[K]V, keys ...K) {
+	missing := utils.MissingKeys(a, keys)
+	unexpected := utils.UnexpectedKeys(a, keys)
+	if len(missing) > 0 || len(unexpected) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEYS MISMATCH(NOT MATCH)", utils.Any("missing", utils.Pick(keys, missing)), utils.Any("unexpected", unexpected), zap.Int("len", len(a)))
+	}
+}
+
+// SubMap checks if each entry of sub is present in the map with a matching value. If not, it panics with the missing and mismatched keys.
+// SubMap 检查 sub 的每个条目是否都以相同的值存在于 map 中，如果不是，则触发 panic 并记录缺失和值不同的键。
+func SubMap[K, V comparable](sub, a map[K]V) {
+	if missing, mismatch := utils.SubMapDiff(sub, a); len(missing) > 0 || len(mismatch) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SUB MAP(SHOULD BE SUB MAP)", utils.Any("missing", missing), utils.Any("mismatch", mismatch), zap.Int("len_sub", len(sub)), zap.Int("len", len(a)))
+	}
+}
+
+// ValuesIn checks if each value of the map is one of the given values. If not, it panics with the keys holding unexpected values.
+// ValuesIn 检查 map 的每个值是否都是给定值之一，如果不是，则触发 panic 并记录持有意外值的键。
+func ValuesIn[K, V comparable](a map[K]V, values ...V) {
+	if unexpected := utils.UnexpectedValueKeys(a, values); len(unexpected) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT IN SET(SHOULD BE IN)", utils.Any("unexpected", unexpected), utils.Any("values", values), zap.Int("len", len(a)))
+	}
+}
+
+// Value gets the value of the key from the map without requiring comparable values. If the key does not exist, it panics.
+// Value 从 map 中获取键对应的值，不要求值可比较，如果键不存在，则触发 panic。
+func Value[K comparable, V any](a map[K]V, key K) V {
+	value, exists := a[key]
+	if !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", utils.Any("key", key))
+	}
+	return value
+}
+
+// GetOr gets the value of the key from the map, returning def when the key does not exist. It never panics.
+// GetOr 从 map 中获取键对应的值，键不存在时返回 def，不会触发 panic。
+func GetOr[K comparable, V any](a map[K]V, key K, def V) V {
+	if value, exists := a[key]; exists {
+		return value
+	}
+	return def
+}
+
+// GetNice gets the value of the key from the map. If the key does not exist or the value is zero, it panics.
+// GetNice 从 map 中获取键对应的值，如果键不存在或值为零，则触发 panic。
+func GetNice[K, V comparable](a map[K]V, key K) V {
+	value, exists := a[key]
+	if !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", utils.Any("key", key))
+	}
+	if value == utils.Zero[V]() {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", utils.Any("key", key), utils.Any("value", value))
+	}
+	return value
+}
+
+// GetAs gets the value of the key from the map and asserts it as type T. If the key does not exist or the type mismatches, it panics.
+// GetAs 从 map 中获取键对应的值并断言为类型 T，如果键不存在或类型不匹配，则触发 panic。
+func GetAs[T any, K comparable](a map[K]any, key K) T {
+	value, exists := a[key]
+	if !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", utils.Any("key", key))
+	}
+	res, ok := value.(T)
+	if !ok {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPE MISMATCH(NOT MATCH)", utils.Any("key", key), zap.String("type", fmt.Sprintf("%T", value)), zap.String("expected", utils.TypeName[T]()))
+	}
+	return res
+}
+
+// GetPath gets the value at the dot-separated path (like "db.primary.host") from nested map[string]any. If any segment is missing or not a map, it panics.
+// GetPath 从嵌套的 map[string]any 中获取点分隔路径（如 "db.primary.host"）上的值，如果某段不存在或不是 map，则触发 panic。
+func GetPath(a map[string]any, path string) any {
+	var value any = a
+	keys := strings.Split(path, ".")
+	for idx, key := range keys {
+		node, ok := value.(map[string]any)
+		if !ok {
+			zaplog.ZAPS.Skip1.LOG.Panic("PATH NOT A MAP(SHOULD BE MAP)", zap.String("path", path), zap.String("at", strings.Join(keys[:idx], ".")), zap.String("type", fmt.Sprintf("%T", value)))
+		}
+		value, ok = node[key]
+		if !ok {
+			zaplog.ZAPS.Skip1.LOG.Panic("PATH NOT IN MAP(SHOULD BE IN)", zap.String("path", path), zap.String("at", strings.Join(keys[:idx+1], ".")), zap.Strings("keys", utils.SortedKeys(node)))
+		}
 	}
 	return value
 }
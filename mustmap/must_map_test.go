@@ -209,3 +209,188 @@ func TestGet(t *testing.T) {
 		mustmap.Get(map[string]int{"a": 1, "b": 2}, "c")
 	})
 }
+
+// TestHasKey tests map key presence assertion
+// Validates HasKey passes when key exists and panics when key not found
+//
+// TestHasKey 测试 map 键存在断言
+// 验证 HasKey 在键存在时通过，在键不存在时 panic
+func TestHasKey(t *testing.T) {
+	mustmap.HasKey(map[string][]string{"a": nil}, "a")
+
+	require.Panics(t, func() {
+		mustmap.HasKey(map[string]int{"a": 1}, "b")
+	})
+}
+
+// TestNotHasKey tests map key absence assertion
+// Validates NotHasKey passes when key not found and panics when key exists
+//
+// TestNotHasKey 测试 map 键不存在断言
+// 验证 NotHasKey 在键不存在时通过，在键存在时 panic
+func TestNotHasKey(t *testing.T) {
+	mustmap.NotHasKey(map[string]int{"a": 1}, "b")
+
+	require.Panics(t, func() {
+		mustmap.NotHasKey(map[string]int{"a": 1}, "a")
+	})
+}
+
+// TestHasKeys tests multiple map keys presence assertion
+// Validates HasKeys passes when each key exists and panics when any key is missing
+//
+// TestHasKeys 测试多个 map 键存在断言
+// 验证 HasKeys 在所有键都存在时通过，在有键缺失时 panic
+func TestHasKeys(t *testing.T) {
+	mustmap.HasKeys(map[string]int{"a": 1, "b": 2, "c": 3}, "a", "c")
+	mustmap.HasKeys(map[string]int{})
+
+	require.Panics(t, func() {
+		mustmap.HasKeys(map[string]int{"a": 1, "b": 2}, "a", "x", "y")
+	})
+}
+
+// TestKeysExactly tests exact map key set assertion
+// Validates KeysExactly passes when key sets match and panics on missing / unexpected keys
+//
+// TestKeysExactly 测试 map 键集合精确匹配断言
+// 验证 KeysExactly 在键集合一致时通过，在有缺失/多余键时 panic
+func TestKeysExactly(t *testing.T) {
+	mustmap.KeysExactly(map[string]int{"a": 1, "b": 2}, "b", "a")
+	mustmap.KeysExactly(map[string]int{})
+
+	require.Panics(t, func() {
+		mustmap.KeysExactly(map[string]int{"a": 1, "b": 2}, "a")
+	})
+
+	require.Panics(t, func() {
+		mustmap.KeysExactly(map[string]int{"a": 1}, "a", "b")
+	})
+}
+
+// TestSubMap tests sub map assertion
+// Validates SubMap passes when each entry is present with same value and panics on missing / mismatched entries
+//
+// TestSubMap 测试子 map 断言
+// 验证 SubMap 在每个条目都以相同值存在时通过，在条目缺失/值不同时 panic
+func TestSubMap(t *testing.T) {
+	mustmap.SubMap(map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2})
+	mustmap.SubMap(map[string]int{}, map[string]int{"a": 1})
+
+	require.Panics(t, func() {
+		mustmap.SubMap(map[string]int{"a": 1, "c": 3}, map[string]int{"a": 1, "b": 2})
+	})
+
+	require.Panics(t, func() {
+		mustmap.SubMap(map[string]int{"a": 2}, map[string]int{"a": 1, "b": 2})
+	})
+}
+
+// TestValuesIn tests map values membership assertion
+// Validates ValuesIn passes when each value is allowed and panics when a value is unexpected
+//
+// TestValuesIn 测试 map 值成员断言
+// 验证 ValuesIn 在每个值都被允许时通过，在有意外值时 panic
+func TestValuesIn(t *testing.T) {
+	mustmap.ValuesIn(map[string]string{"a": "on", "b": "off"}, "on", "off")
+
+	require.Panics(t, func() {
+		mustmap.ValuesIn(map[string]string{"a": "on", "b": "maybe"}, "on", "off")
+	})
+}
+
+// TestValue tests map key lookup with non-comparable values
+// Validates Value returns the value when key exists and panics when key not found
+//
+// TestValue 测试值不可比较的 map 键查找
+// 验证 Value 在键存在时返回值，在键不存在时 panic
+func TestValue(t *testing.T) {
+	headers := map[string][]string{"Accept": {"text/html", "application/json"}}
+	require.Equal(t, []string{"text/html", "application/json"}, mustmap.Value(headers, "Accept"))
+
+	require.Panics(t, func() {
+		mustmap.Value(headers, "Cookie")
+	})
+}
+
+// TestGetOr tests map key lookup with a default value
+// Validates GetOr returns the stored value when the key exists, even a zero value, and the default when not
+//
+// TestGetOr 测试带默认值的 map 键查找
+// 验证 GetOr 在键存在时返回存储的值（即使是零值），不存在时返回默认值
+func TestGetOr(t *testing.T) {
+	config := map[string][]string{"hosts": {"a", "b"}, "empty": nil}
+	require.Equal(t, []string{"a", "b"}, mustmap.GetOr(config, "hosts", []string{"localhost"}))
+	require.Nil(t, mustmap.GetOr(config, "empty", []string{"localhost"}))
+	require.Equal(t, []string{"localhost"}, mustmap.GetOr(config, "missing", []string{"localhost"}))
+	require.Equal(t, 8080, mustmap.GetOr(map[string]int{}, "port", 8080))
+}
+
+// TestGetNice tests map key lookup with non-zero value check
+// Validates GetNice returns non-zero values and panics when key not found / value is zero
+//
+// TestGetNice 测试 map 键查找并检查非零值
+// 验证 GetNice 返回非零值，在键不存在/值为零时 panic
+func TestGetNice(t *testing.T) {
+	require.Equal(t, 1, mustmap.GetNice(map[string]int{"a": 1, "b": 0}, "a"))
+
+	require.Panics(t, func() {
+		mustmap.GetNice(map[string]int{"a": 1, "b": 0}, "b")
+	})
+
+	require.Panics(t, func() {
+		mustmap.GetNice(map[string]int{"a": 1, "b": 0}, "c")
+	})
+}
+
+// TestGetAs tests map key lookup with type assertion
+// Validates GetAs returns typed values and panics when key not found / type mismatches
+//
+// TestGetAs 测试 map 键查找并进行类型断言
+// 验证 GetAs 返回类型化的值，在键不存在/类型不匹配时 panic
+func TestGetAs(t *testing.T) {
+	data := map[string]any{"port": 8080, "host": "localhost", "err": nil}
+	require.Equal(t, 8080, mustmap.GetAs[int](data, "port"))
+	require.Equal(t, "localhost", mustmap.GetAs[string](data, "host"))
+	require.Equal(t, "localhost", mustmap.GetAs[any](data, "host"))
+
+	require.Panics(t, func() {
+		mustmap.GetAs[string](data, "port")
+	})
+
+	require.Panics(t, func() {
+		mustmap.GetAs[error](data, "err")
+	})
+
+	require.Panics(t, func() {
+		mustmap.GetAs[int](data, "missing")
+	})
+}
+
+// TestGetPath tests nested map lookup by dot-separated path
+// Validates GetPath returns nested values and panics when a segment is missing or not a map
+//
+// TestGetPath 测试按点分隔路径的嵌套 map 查找
+// 验证 GetPath 返回嵌套值，在某段缺失或不是 map 时 panic
+func TestGetPath(t *testing.T) {
+	config := map[string]any{
+		"db": map[string]any{
+			"primary": map[string]any{
+				"host": "10.0.0.1",
+				"port": 5432,
+			},
+		},
+		"debug": true,
+	}
+	require.Equal(t, "10.0.0.1", mustmap.GetPath(config, "db.primary.host"))
+	require.Equal(t, 5432, mustmap.GetPath(config, "db.primary.port"))
+	require.Equal(t, true, mustmap.GetPath(config, "debug"))
+
+	require.Panics(t, func() {
+		mustmap.GetPath(config, "db.replica.host")
+	})
+
+	require.Panics(t, func() {
+		mustmap.GetPath(config, "debug.level")
+	})
+}
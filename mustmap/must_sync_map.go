@@ -0,0 +1,118 @@
+package mustmap
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// Map is the adapter interface letting custom map types (LRU caches, ordered maps) plug into mustmap assertions
+// Only the Map* functions (MapGet, MapHasKey, MapNotHasKey, MapLen) accept it, the other assertions take built-in maps
+//
+// Map 是适配器接口，让自定义 map 类型（LRU 缓存、有序 map）接入 mustmap 断言
+// 只有 Map* 函数（MapGet、MapHasKey、MapNotHasKey、MapLen）接受它，其余断言接受内置 map
+type Map[K comparable, V any] interface {
+	Load(key K) (V, bool) // Load returns the value and whether the key exists // Load 返回值以及键是否存在
+	Len() int             // Len returns the count of entries // Len 返回条目数量
+}
+
+// SyncGet gets the value of the key from the sync.Map and asserts it as type V. If the key does not exist or the type mismatches, it panics.
+// A stored nil is returned as the zero V when V can hold nil (interface, pointer, map, slice, func, chan).
+//
+// SyncGet 从 sync.Map 中获取键对应的值并断言为类型 V，如果键不存在或类型不匹配，则触发 panic。
+// 当 V 可以为 nil（接口、指针、map、切片、函数、通道）时，存储的 nil 作为 V 的零值返回。
+func SyncGet[K comparable, V any](m *sync.Map, key K) V {
+	value, exists := m.Load(key)
+	if !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", utils.Any("key", key))
+	}
+	if value == nil && nillable[V]() {
+		return utils.Zero[V]()
+	}
+	res, ok := value.(V)
+	if !ok {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPE MISMATCH(NOT MATCH)", utils.Any("key", key), zap.String("type", fmt.Sprintf("%T", value)), zap.String("expected", utils.TypeName[V]()))
+	}
+	return res
+}
+
+// nillable reports whether the zero value of V is nil
+// nillable 判断 V 的零值是否为 nil
+func nillable[V any]() bool {
+	switch reflect.TypeFor[V]().Kind() {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return true
+	default:
+		return false
+	}
+}
+
+// SyncHasKey checks if the key exists in the sync.Map. If not, it panics.
+// SyncHasKey 检查键是否存在于 sync.Map 中，如果不存在，则触发 panic。
+func SyncHasKey[K comparable](m *sync.Map, key K) {
+	if _, exists := m.Load(key); !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", utils.Any("key", key))
+	}
+}
+
+// SyncNotHasKey checks if the key is absent from the sync.Map. If present, it panics.
+// SyncNotHasKey 检查键是否不在 sync.Map 中，如果存在，则触发 panic。
+func SyncNotHasKey[K comparable](m *sync.Map, key K) {
+	if _, exists := m.Load(key); exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY IN MAP(SHOULD NOT BE IN)", utils.Any("key", key))
+	}
+}
+
+// SyncLen checks if the count of entries in the sync.Map matches n. If not, it panics.
+// Note: the count is a snapshot taken by Range, concurrent writes may change it.
+// SyncLen 检查 sync.Map 中的条目数量是否等于 n，如果不等，则触发 panic。
+// 注意：数量是通过 Range 获取的快照，并发写入可能会改变它。
+func SyncLen(m *sync.Map, n int) {
+	cnt := 0
+	m.Range(func(_, _ any) bool {
+		cnt++
+		return true
+	})
+	if cnt != n {
+		zaplog.ZAPS.Skip1.LOG.Panic("LENGTH MISMATCH(NOT MATCH)", zap.Int("len", cnt), zap.Int("n", n))
+	}
+}
+
+// MapGet gets the value of the key from the custom map. If the key does not exist, it panics.
+// MapGet 从自定义 map 中获取键对应的值，如果键不存在，则触发 panic。
+func MapGet[K comparable, V any](m Map[K, V], key K) V {
+	value, exists := m.Load(key)
+	if !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", utils.Any("key", key), zap.Int("len", m.Len()))
+		return utils.Zero[V]()
+	}
+	return value
+}
+
+// MapHasKey checks if the key exists in the custom map. If not, it panics.
+// MapHasKey 检查键是否存在于自定义 map 中，如果不存在，则触发 panic。
+func MapHasKey[K comparable, V any](m Map[K, V], key K) {
+	if _, exists := m.Load(key); !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", utils.Any("key", key), zap.Int("len", m.Len()))
+	}
+}
+
+// MapNotHasKey checks if the key is absent from the custom map. If present, it panics.
+// MapNotHasKey 检查键是否不在自定义 map 中，如果存在，则触发 panic。
+func MapNotHasKey[K comparable, V any](m Map[K, V], key K) {
+	if _, exists := m.Load(key); exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY IN MAP(SHOULD NOT BE IN)", utils.Any("key", key), zap.Int("len", m.Len()))
+	}
+}
+
+// MapLen checks if the length of the custom map matches n. If not, it panics.
+// MapLen 检查自定义 map 的长度是否等于 n，如果不等，则触发 panic。
+func MapLen[K comparable, V any](m Map[K, V], n int) {
+	if m.Len() != n {
+		zaplog.ZAPS.Skip1.LOG.Panic("LENGTH MISMATCH(NOT MATCH)", zap.Int("len", m.Len()), zap.Int("n", n))
+	}
+}
@@ -0,0 +1,192 @@
+package mustmap_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustmap"
+)
+
+// TestSyncGet tests sync.Map key lookup with type check
+// Validates SyncGet returns typed values and panics when key not found / type mismatches
+//
+// TestSyncGet 测试 sync.Map 键查找并检查类型
+// 验证 SyncGet 返回类型化的值，在键不存在/类型不匹配时 panic
+func TestSyncGet(t *testing.T) {
+	var cache sync.Map
+	cache.Store("a", 1)
+	cache.Store("b", "x")
+
+	require.Equal(t, 1, mustmap.SyncGet[string, int](&cache, "a"))
+
+	require.Panics(t, func() {
+		mustmap.SyncGet[string, int](&cache, "b")
+	})
+
+	require.Panics(t, func() {
+		mustmap.SyncGet[string, int](&cache, "c")
+	})
+}
+
+// TestSyncGet_Nil tests sync.Map lookup of a stored nil value
+// Validates a stored nil returns the zero value of nillable types and panics on other types
+//
+// TestSyncGet_Nil 测试 sync.Map 中存储 nil 值的查找
+// 验证存储的 nil 对可为 nil 的类型返回零值，对其它类型 panic
+func TestSyncGet_Nil(t *testing.T) {
+	var cache sync.Map
+	cache.Store("a", nil)
+
+	require.Nil(t, mustmap.SyncGet[string, error](&cache, "a"))
+	require.Nil(t, mustmap.SyncGet[string, any](&cache, "a"))
+	require.Nil(t, mustmap.SyncGet[string, *int](&cache, "a"))
+	require.Nil(t, mustmap.SyncGet[string, []string](&cache, "a"))
+
+	require.Panics(t, func() {
+		mustmap.SyncGet[string, int](&cache, "a")
+	})
+}
+
+// TestSyncHasKey tests sync.Map key presence assertion
+// Validates SyncHasKey passes when key exists and panics when key not found
+//
+// TestSyncHasKey 测试 sync.Map 键存在断言
+// 验证 SyncHasKey 在键存在时通过，在键不存在时 panic
+func TestSyncHasKey(t *testing.T) {
+	var cache sync.Map
+	cache.Store(1, nil)
+
+	mustmap.SyncHasKey(&cache, 1)
+
+	require.Panics(t, func() {
+		mustmap.SyncHasKey(&cache, 2)
+	})
+}
+
+// TestSyncNotHasKey tests sync.Map key absence assertion
+// Validates SyncNotHasKey passes when key not found and panics when key exists
+//
+// TestSyncNotHasKey 测试 sync.Map 键不存在断言
+// 验证 SyncNotHasKey 在键不存在时通过，在键存在时 panic
+func TestSyncNotHasKey(t *testing.T) {
+	var cache sync.Map
+	cache.Store(1, nil)
+
+	mustmap.SyncNotHasKey(&cache, 2)
+
+	require.Panics(t, func() {
+		mustmap.SyncNotHasKey(&cache, 1)
+	})
+}
+
+// TestSyncLen tests sync.Map length assertion
+// Validates SyncLen passes when entry count matches expected value and panics on mismatch
+//
+// TestSyncLen 测试 sync.Map 长度断言
+// 验证 SyncLen 在条目数量匹配期望值时通过，在不匹配时 panic
+func TestSyncLen(t *testing.T) {
+	var cache sync.Map
+	mustmap.SyncLen(&cache, 0)
+
+	cache.Store("a", 1)
+	cache.Store("b", 2)
+	mustmap.SyncLen(&cache, 2)
+
+	require.Panics(t, func() {
+		mustmap.SyncLen(&cache, 3)
+	})
+}
+
+// orderedMap is a custom map type keeping insertion order, used to test the adapter interface
+// orderedMap 是保持插入顺序的自定义 map 类型，用于测试适配器接口
+type orderedMap struct {
+	keys   []string
+	values map[string]int
+}
+
+func newOrderedMap() *orderedMap {
+	return &orderedMap{values: map[string]int{}}
+}
+
+func (m *orderedMap) Set(key string, value int) {
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+func (m *orderedMap) Load(key string) (int, bool) {
+	value, exists := m.values[key]
+	return value, exists
+}
+
+func (m *orderedMap) Len() int {
+	return len(m.keys)
+}
+
+// TestMapGet tests custom map key lookup through the adapter interface
+// Validates MapGet returns the value when key exists and panics when key not found
+//
+// TestMapGet 测试通过适配器接口查找自定义 map 的键
+// 验证 MapGet 在键存在时返回值，在键不存在时 panic
+func TestMapGet(t *testing.T) {
+	m := newOrderedMap()
+	m.Set("a", 1)
+
+	require.Equal(t, 1, mustmap.MapGet[string, int](m, "a"))
+
+	require.Panics(t, func() {
+		mustmap.MapGet[string, int](m, "b")
+	})
+}
+
+// TestMapHasKey tests custom map key presence assertion
+// Validates MapHasKey passes when key exists and panics when key not found
+//
+// TestMapHasKey 测试自定义 map 键存在断言
+// 验证 MapHasKey 在键存在时通过，在键不存在时 panic
+func TestMapHasKey(t *testing.T) {
+	m := newOrderedMap()
+	m.Set("a", 1)
+
+	mustmap.MapHasKey[string, int](m, "a")
+
+	require.Panics(t, func() {
+		mustmap.MapHasKey[string, int](m, "b")
+	})
+}
+
+// TestMapNotHasKey tests custom map key absence assertion
+// Validates MapNotHasKey passes when key not found and panics when key exists
+//
+// TestMapNotHasKey 测试自定义 map 键不存在断言
+// 验证 MapNotHasKey 在键不存在时通过，在键存在时 panic
+func TestMapNotHasKey(t *testing.T) {
+	m := newOrderedMap()
+	m.Set("a", 1)
+
+	mustmap.MapNotHasKey[string, int](m, "b")
+
+	require.Panics(t, func() {
+		mustmap.MapNotHasKey[string, int](m, "a")
+	})
+}
+
+// TestMapLen tests custom map length assertion
+// Validates MapLen passes when length matches expected value and panics on mismatch
+//
+// TestMapLen 测试自定义 map 长度断言
+// 验证 MapLen 在长度匹配期望值时通过，在不匹配时 panic
+func TestMapLen(t *testing.T) {
+	m := newOrderedMap()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("a", 3)
+
+	mustmap.MapLen[string, int](m, 2)
+
+	require.Panics(t, func() {
+		mustmap.MapLen[string, int](m, 3)
+	})
+}
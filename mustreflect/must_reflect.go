@@ -0,0 +1,66 @@
+// Package mustreflect provides type and kind assertion utilities on dynamic values with panic-on-failure semantics
+// Implements checked type assertions, kind checks, interface implementation checks and typed-nil detection
+// Supports struct introspection through field existence checks and typed field extraction
+// Integrates with zap structured logging, logging the actual dynamic type when assertions are not met
+//
+// mustreflect 提供针对动态值的类型和种类断言工具，带 panic-on-failure 语义
+// 实现带检查的类型断言、种类检查、接口实现检查和类型化 nil 检测
+// 通过字段存在检查和带类型的字段提取支持结构体内省
+// 与 zap 结构化日志集成，当断言不满足时记录实际的动态类型
+package mustreflect
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// As asserts v holds a value of type T and returns it. Panics with the actual dynamic type if not.
+// When T is an interface type, v must implement T.
+//
+// As 断言 v 持有 T 类型的值并返回该值。如果不是则触发 panic，并记录实际的动态类型。
+// 当 T 是接口类型时，v 必须实现 T。
+func As[T any](v any) T {
+	res, ok := v.(T)
+	if !ok {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPE MISMATCH(SHOULD BE TYPE)", zap.String("expected", utils.TypeName[T]()), zap.String("actual", fmt.Sprintf("%T", v)))
+	}
+	return res
+}
+
+// Kind expects the dynamic value of v to be of the kind, a nil v has the kind reflect.Invalid. Panics with the actual kind and type if not.
+// Kind 期望 v 的动态值属于该种类，nil 的 v 种类为 reflect.Invalid。如果不是则触发 panic，并记录实际的种类和类型。
+func Kind(v any, kind reflect.Kind) {
+	if actual := reflect.ValueOf(v).Kind(); actual != kind {
+		zaplog.ZAPS.Skip1.LOG.Panic("KIND MISMATCH(SHOULD BE KIND)", zap.Stringer("expected", kind), zap.Stringer("actual", actual), zap.String("type", fmt.Sprintf("%T", v)))
+	}
+}
+
+// Implements expects the dynamic type of v to implement the interface type I. Panics if I is not an interface type or v does not implement it.
+// Implements 期望 v 的动态类型实现接口类型 I。如果 I 不是接口类型或 v 未实现 I 则触发 panic。
+func Implements[I any](v any) {
+	iface := reflect.TypeOf((*I)(nil)).Elem()
+	if iface.Kind() != reflect.Interface {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT INTERFACE TYPE(SHOULD BE INTERFACE)", zap.Stringer("expected", iface))
+	}
+	if v == nil || !reflect.TypeOf(v).Implements(iface) {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT IMPLEMENTS(SHOULD IMPLEMENT)", zap.Stringer("expected", iface), zap.String("actual", fmt.Sprintf("%T", v)))
+	}
+}
+
+// NotNilInterface expects v to be non-nil and not a typed nil, i.e. not an interface wrapping a nil pointer, map, slice, func or chan.
+// Panics with a "TYPED NIL" message and the dynamic type when v wraps such a nil.
+//
+// NotNilInterface 期望 v 非 nil 且不是类型化 nil，即不是包装了 nil 指针、map、切片、函数或通道的接口。
+// 当 v 包装了这类 nil 时触发 panic，记录 "TYPED NIL" 消息以及动态类型。
+func NotNilInterface(v any) {
+	if v == nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("INTERFACE IS NIL(SHOULD NOT BE NIL)")
+	}
+	if utils.IsTypedNil(v) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", v)))
+	}
+}
@@ -0,0 +1,83 @@
+package mustreflect
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// FieldExists expects v to be a struct (or a pointer to a struct) having the named field, promoted fields included.
+// Panics if v is not a struct or the field does not exist, logging the field names of the struct.
+//
+// FieldExists 期望 v 是拥有该名称字段的结构体（或结构体指针），包括提升的字段。
+// 如果 v 不是结构体或字段不存在则触发 panic，并记录结构体的字段名称。
+func FieldExists(v any, name string) {
+	value, ok := structValue(v)
+	if !ok {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT STRUCT(SHOULD BE STRUCT)", zap.String("type", fmt.Sprintf("%T", v)), zap.String("name", name))
+	}
+	if _, exists := value.Type().FieldByName(name); !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("FIELD NOT EXISTS(SHOULD EXIST)", zap.String("type", fmt.Sprintf("%T", v)), zap.String("name", name), zap.Strings("fields", fieldNames(value.Type())))
+	}
+}
+
+// Field returns the value of the named exported field of v (a struct or a pointer to a struct) as type T.
+// Panics if v is not a struct, the field does not exist or is unexported, or the field type is not assignable to T.
+//
+// Field 以 T 类型返回 v（结构体或结构体指针）中该名称的导出字段的值。
+// 如果 v 不是结构体、字段不存在或未导出、或字段类型不能赋值给 T 则触发 panic。
+func Field[T any](v any, name string) T {
+	value, ok := structValue(v)
+	if !ok {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT STRUCT(SHOULD BE STRUCT)", zap.String("type", fmt.Sprintf("%T", v)), zap.String("name", name))
+	}
+	field, exists := value.Type().FieldByName(name)
+	if !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("FIELD NOT EXISTS(SHOULD EXIST)", zap.String("type", fmt.Sprintf("%T", v)), zap.String("name", name), zap.Strings("fields", fieldNames(value.Type())))
+	}
+	if !field.IsExported() {
+		zaplog.ZAPS.Skip1.LOG.Panic("FIELD NOT EXPORTED(SHOULD BE EXPORTED)", zap.String("type", fmt.Sprintf("%T", v)), zap.String("name", name))
+	}
+	fieldValue, err := value.FieldByIndexErr(field.Index)
+	if err != nil {
+		// promoted through a nil embedded pointer
+		zaplog.ZAPS.Skip1.LOG.Panic("FIELD NOT REACHABLE(SHOULD BE REACHABLE)", zap.String("type", fmt.Sprintf("%T", v)), zap.String("name", name), zap.Error(err))
+	}
+	if !fieldValue.CanInterface() {
+		// reflect refuses to expose the value, like a field reached through unexported fields
+		zaplog.ZAPS.Skip1.LOG.Panic("FIELD NOT EXPORTED(SHOULD BE EXPORTED)", zap.String("type", fmt.Sprintf("%T", v)), zap.String("name", name))
+	}
+	var res T
+	resValue := reflect.ValueOf(&res).Elem()
+	if !field.Type.AssignableTo(resValue.Type()) {
+		zaplog.ZAPS.Skip1.LOG.Panic("FIELD TYPE MISMATCH(SHOULD BE TYPE)", zap.String("type", fmt.Sprintf("%T", v)), zap.String("name", name), zap.String("expected", utils.TypeName[T]()), zap.Stringer("actual", field.Type))
+	}
+	resValue.Set(fieldValue)
+	return res
+}
+
+// structValue dereferences pointers until reaching a struct, returns false when v is not a struct or a nil pointer is met
+// structValue 解引用指针直到得到结构体，当 v 不是结构体或遇到 nil 指针时返回 false
+func structValue(v any) (reflect.Value, bool) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+		value = value.Elem()
+	}
+	return value, value.Kind() == reflect.Struct
+}
+
+// fieldNames returns the names of the direct fields of the struct type
+// fieldNames 返回结构体类型的直接字段名称
+func fieldNames(structType reflect.Type) []string {
+	names := make([]string, 0, structType.NumField())
+	for idx := 0; idx < structType.NumField(); idx++ {
+		names = append(names, structType.Field(idx).Name)
+	}
+	return names
+}
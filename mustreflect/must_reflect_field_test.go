@@ -0,0 +1,82 @@
+package mustreflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustreflect"
+)
+
+type Base struct {
+	ID int
+}
+
+type inner struct {
+	Hidden string
+}
+
+type User struct {
+	Base
+	inner
+	Name  string
+	Err   error
+	Tags  []string
+	Owner *Base
+	age   int
+}
+
+// TestFieldExists tests struct field existence assertion
+// Validates FieldExists passes with direct and promoted fields on structs and pointers, and panics otherwise
+//
+// TestFieldExists 测试结构体字段存在断言
+// 验证 FieldExists 在结构体和指针的直接字段和提升字段上通过，其他情况 panic
+func TestFieldExists(t *testing.T) {
+	user := User{Name: "a", age: 1}
+	mustreflect.FieldExists(user, "Name")
+	mustreflect.FieldExists(&user, "ID")
+	mustreflect.FieldExists(user, "age")
+
+	require.Panics(t, func() {
+		mustreflect.FieldExists(user, "Email")
+	})
+
+	require.Panics(t, func() {
+		mustreflect.FieldExists(42, "Name")
+	})
+
+	require.Panics(t, func() {
+		mustreflect.FieldExists((*User)(nil), "Name")
+	})
+}
+
+// TestField tests typed struct field extraction
+// Validates Field returns exported field values as T and panics with missing, unexported or mistyped fields
+//
+// TestField 测试带类型的结构体字段提取
+// 验证 Field 以 T 类型返回导出字段的值，在字段缺失、未导出或类型不符时 panic
+func TestField(t *testing.T) {
+	user := &User{Base: Base{ID: 7}, Name: "a", Tags: []string{"x"}, age: 1}
+	require.Equal(t, "a", mustreflect.Field[string](user, "Name"))
+	require.Equal(t, 7, mustreflect.Field[int](*user, "ID"))
+	require.Equal(t, []string{"x"}, mustreflect.Field[[]string](user, "Tags"))
+	require.Nil(t, mustreflect.Field[error](user, "Err"))
+	require.Nil(t, mustreflect.Field[*Base](user, "Owner"))
+	require.Equal(t, Base{ID: 7}, mustreflect.Field[any](user, "Base"))
+	require.Equal(t, "", mustreflect.Field[string](user, "Hidden")) // promoted through an unexported embedded struct
+
+	require.Panics(t, func() {
+		mustreflect.Field[string](user, "Email")
+	})
+
+	require.Panics(t, func() {
+		mustreflect.Field[int](user, "age")
+	})
+
+	require.Panics(t, func() {
+		mustreflect.Field[int](user, "Name")
+	})
+
+	require.Panics(t, func() {
+		mustreflect.Field[string]("abc", "Name")
+	})
+}
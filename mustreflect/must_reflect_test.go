@@ -0,0 +1,120 @@
+// Package mustreflect_test provides comprehensive testing of mustreflect assertion package
+// Tests include checked type assertions, kind checks, interface implementation and typed-nil detection
+// Checks each assertion functions with both success and failure cases
+//
+// mustreflect_test 为 mustreflect 断言包提供全面的测试
+// 测试涵盖带检查的类型断言、种类检查、接口实现和类型化 nil 检测
+// 使用成功和失败案例验证所有断言函数
+package mustreflect_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustreflect"
+)
+
+// TestAs tests checked type assertion
+// Validates As returns the value with concrete and interface types and panics with other types
+//
+// TestAs 测试带检查的类型断言
+// 验证 As 在具体类型和接口类型时返回值，在其他类型时 panic
+func TestAs(t *testing.T) {
+	require.Equal(t, 42, mustreflect.As[int](any(42)))
+	builder := &strings.Builder{}
+	stringer := mustreflect.As[fmt.Stringer](any(builder))
+	require.IsType(t, &strings.Builder{}, stringer)
+	require.Same(t, builder, stringer)
+	require.Nil(t, mustreflect.As[*os.File](any((*os.File)(nil))))
+
+	require.Panics(t, func() {
+		mustreflect.As[int](any("42"))
+	})
+
+	require.Panics(t, func() {
+		mustreflect.As[int](nil)
+	})
+
+	require.Panics(t, func() {
+		mustreflect.As[io.Reader](any(42))
+	})
+}
+
+// TestKind tests kind assertion
+// Validates Kind passes when the dynamic kind matches, treating a nil v as reflect.Invalid, and panics when it differs
+//
+// TestKind 测试种类断言
+// 验证 Kind 在动态种类相同时通过（nil 的 v 视为 reflect.Invalid），在种类不同时 panic
+func TestKind(t *testing.T) {
+	mustreflect.Kind(struct{}{}, reflect.Struct)
+	mustreflect.Kind(&struct{}{}, reflect.Pointer)
+	mustreflect.Kind(map[string]int{}, reflect.Map)
+	mustreflect.Kind(nil, reflect.Invalid)
+
+	require.Panics(t, func() {
+		mustreflect.Kind(&struct{}{}, reflect.Struct)
+	})
+
+	require.Panics(t, func() {
+		mustreflect.Kind(nil, reflect.Struct)
+	})
+}
+
+// TestImplements tests interface implementation assertion
+// Validates Implements passes when v implements I and panics when not, when v is nil, or when I is not an interface
+//
+// TestImplements 测试接口实现断言
+// 验证 Implements 在 v 实现 I 时通过，在未实现、v 为 nil 或 I 不是接口时 panic
+func TestImplements(t *testing.T) {
+	mustreflect.Implements[error](errors.New("x"))
+	mustreflect.Implements[io.Reader](strings.NewReader("x"))
+	mustreflect.Implements[any](42)
+
+	require.Panics(t, func() {
+		mustreflect.Implements[io.Writer](strings.NewReader("x"))
+	})
+
+	require.Panics(t, func() {
+		mustreflect.Implements[error](nil)
+	})
+
+	require.Panics(t, func() {
+		mustreflect.Implements[int](42)
+	})
+}
+
+// TestNotNilInterface tests typed-nil interface detection
+// Validates NotNilInterface passes with set values and panics with untyped nil and interfaces wrapping nil values
+//
+// TestNotNilInterface 测试类型化 nil 接口检测
+// 验证 NotNilInterface 在值已设置时通过，在无类型 nil 和包装了 nil 值的接口时 panic
+func TestNotNilInterface(t *testing.T) {
+	mustreflect.NotNilInterface(errors.New("x"))
+	mustreflect.NotNilInterface(0)
+	mustreflect.NotNilInterface([]int{})
+
+	require.Panics(t, func() {
+		mustreflect.NotNilInterface(nil)
+	})
+
+	require.Panics(t, func() {
+		var pathErr *os.PathError
+		var err error = pathErr
+		mustreflect.NotNilInterface(err)
+	})
+
+	require.Panics(t, func() {
+		var reader io.Reader = (*os.File)(nil)
+		mustreflect.NotNilInterface(reader)
+	})
+
+	require.Panics(t, func() {
+		mustreflect.NotNilInterface((map[string]int)(nil))
+	})
+}
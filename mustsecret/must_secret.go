@@ -2,51 +2,281 @@
 // Implements validation functions that avoid logging data values to prevent information leakage
 // Panic messages exclude data values to prevent leaking secrets in logs
 // Integrates with zap structured logging but omits sensitive value details
+// Mirrors the root must assertions and the value-logging ones in muststrings, mustslice, mustmap and mustnum
+// Names match the counterparts, except HasSubstring / NotHasSubstring (muststrings Contains / NotContains)
+// A Redaction (fingerprint, length, mask) logs a safe representation of the values, passed per call or set with SetRedaction
+// Assertions ending with variadic values (OneOf, ContainsAny, ContainsAll, HasKeys, KeysExactly, ValuesIn) use SetRedaction only
 //
 // mustsecret 提供断言工具，带 panic-on-failure 语义，专门用于保护敏感数据
 // 实现避免记录实际值的验证函数，以防止信息泄露
 // 所有 panic 消息都排除数据值，防止在日志中泄露机密
 // 与 zap 结构化日志集成，但省略敏感值详情
+// 覆盖 must 根包断言以及 muststrings、mustslice、mustmap 和 mustnum 中会记录值的断言
+// 名称与对应函数一致，除了 HasSubstring / NotHasSubstring（对应 muststrings 的 Contains / NotContains）
+// Redaction（指纹、长度、遮盖）记录值的安全表示形式，可在每次调用时传入，或通过 SetRedaction 设置
+// 以可变参数结尾的断言（OneOf、ContainsAny、ContainsAll、HasKeys、KeysExactly、ValuesIn）只使用 SetRedaction
 package mustsecret
 
 import (
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
 	"github.com/yyle88/must/internal/utils"
 	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
 )
 
-// Nice expects a non-zero value. Panics if the value is zero, returns the value if non-zero.
-// Nice 期望一个非零值。如果值为零，则触发 panic；如果值非零，则返回该值。
-func Nice[V comparable](a V) V {
+// Nice expects a non-zero value. Panics if the value is zero or a typed nil (an interface wrapping a nil pointer), returns the value if non-zero.
+// Nice 期望一个非零值。如果值为零或为类型化 nil（包装了 nil 指针的接口），则触发 panic；如果值非零，则返回该值。
+func Nice[V comparable](a V, opts ...Redaction) V {
 	if a == utils.Zero[V]() {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)") // not show data in the log message
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", redact("a", a, opts)) // only show redacted data in the log message
+	}
+	if utils.IsTypedNil(a) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", a)))
 	}
 	return a
 }
 
 // Zero expects a zero value. Panics if the value is non-zero.
 // Zero 期望值为零。如果值不为零，则触发 panic。
-func Zero[V comparable](a V) {
+func Zero[V comparable](a V, opts ...Redaction) {
 	if a != utils.Zero[V]() {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS NOT ZERO(SHOULD BE ZERO)") // not show data in the log message
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS NOT ZERO(SHOULD BE ZERO)", redact("a", a, opts)) // only show redacted data in the log message
 	}
 }
 
 // Same expects the values to match. Panics if not matching.
 // Same 期望值相等。如果值不相等，则触发 panic。
-func Same[V comparable](a, b V) {
+func Same[V comparable](a, b V, opts ...Redaction) {
 	if a != b {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)") // not show data in the log message
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
 	}
 }
 
 // Sane means same && nice
 // Sane 期望值相等且非零。如果值不相等/为零，则触发 panic。如果条件满足，则返回该值。
-func Sane[V comparable](a, b V) V {
+func Sane[V comparable](a, b V, opts ...Redaction) V {
 	if a != b {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)") // not show data in the log message
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
 	}
 	if a == utils.Zero[V]() {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)") // not show data in the log message
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", redact("a", a, opts)) // only show redacted data in the log message
 	}
 	return a
 }
+
+// True expects the value to be true. Panics if the value is false.
+// True 期望值为 true。如果值为 false，则触发 panic。
+func True(v bool) {
+	if !v {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS FALSE(SHOULD BE TRUE)") // not show data in the log message
+	}
+}
+
+// TRUE expects the value to be true. Panics if the value is false.
+// TRUE 期望值为 true。如果值为 false，则触发 panic。
+func TRUE(v bool) {
+	if !v {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS FALSE(SHOULD BE TRUE)") // not show data in the log message
+	}
+}
+
+// False expects the value to be false. Panics if the value is true.
+// False 期望值为 false。如果值为 true，则触发 panic。
+func False(v bool) {
+	if v {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS TRUE(SHOULD BE FALSE)") // not show data in the log message
+	}
+}
+
+// FALSE expects the value to be false. Panics if the value is true.
+// FALSE 期望值为 false。如果值为 true，则触发 panic。
+func FALSE(v bool) {
+	if v {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS TRUE(SHOULD BE FALSE)") // not show data in the log message
+	}
+}
+
+// Done expects no error. Panics if the error is non-nil, logging the error type but not the message.
+// Done 期望没有错误。如果错误不为 nil，则触发 panic，只记录错误类型而不记录错误信息。
+func Done(err error) {
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("EXPECTED NO ERROR(BUT HAS ERROR)", zap.String("type", fmt.Sprintf("%T", err))) // not show message in the log
+	}
+}
+
+// Must expects no error. Panics if the error is non-nil, logging the error type but not the message.
+// Must 期望没有错误。如果错误不为 nil，则触发 panic，只记录错误类型而不记录错误信息。
+func Must(err error) {
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("HAS ERROR(SHOULD BE NO ERROR)", zap.String("type", fmt.Sprintf("%T", err))) // not show message in the log
+	}
+}
+
+// None expects a zero value. Panics if the value is non-zero.
+// None 期望值为零。如果值不为零，则触发 panic。
+func None[V comparable](a V, opts ...Redaction) {
+	if a != utils.Zero[V]() {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS NOT ZERO(SHOULD BE ZERO)", redact("a", a, opts)) // only show redacted data in the log message
+	}
+}
+
+// Null expects the value to be nil. Panics if the value is non-nil.
+// Null 期望值为 nil。如果值不为 nil，则触发 panic。
+func Null[T any](v *T) {
+	if v != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE PRESENT(SHOULD BE ABSENT)")
+	}
+}
+
+// Full expects the value to be non-nil. Panics if the value is nil, or points to an interface wrapping a nil value.
+// Full 期望值为非 nil。如果值为 nil，或指向包装了 nil 值的接口，则触发 panic。
+func Full[T any](v *T) *T {
+	if v == nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE ABSENT(SHOULD BE PRESENT)")
+	}
+	if utils.HoldsTypedNil(v) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", *v)))
+	}
+	return v
+}
+
+// Equals expects the values to match. Panics if not matching.
+// Equals 期望值相等。如果值不相等，则触发 panic。
+func Equals[V comparable](a, b V, opts ...Redaction) {
+	if a != b {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// SameNice expects the values to match and be non-zero. Panics if not matching / when zero. Returns the value when conditions are met.
+// SameNice 期望值相等且非零。如果值不相等/为零，则触发 panic。如果条件满足，则返回该值。
+func SameNice[V comparable](a, b V, opts ...Redaction) V {
+	if a != b {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+	if a == utils.Zero[V]() {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", redact("a", a, opts)) // only show redacted data in the log message
+	}
+	return a
+}
+
+// Diff expects the values to be distinct. Panics if the values match.
+// Diff 期望值不同。如果值相同，则触发 panic。
+func Diff[V comparable](a, b V, opts ...Redaction) {
+	if a == b {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES ARE SAME(SHOULD BE DIFFERENT)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// Different expects the values to be distinct. Panics if the values match.
+// Different 期望值不同。如果值相同，则触发 panic。
+func Different[V comparable](a, b V, opts ...Redaction) {
+	if a == b {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES ARE SAME(SHOULD BE DIFFERENT)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// Is expects matching values, not the logic of errors.Is, but the logic of Equals. Panics if the values do not match.
+// Is 期望相等，不是 errors.Is 的逻辑，而是 Equals 的逻辑。如果值不相等，则触发 panic。
+func Is[V comparable](a, b V, opts ...Redaction) {
+	if a != b {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// Ise expects the errors to match, using the logic of errors.Is. Panics if not matching, logging the error types but not the messages.
+// Ise 期望错误相等，类似于 errors.Is 的行为。如果错误不相等，则触发 panic，只记录错误类型而不记录错误信息。
+func Ise(err, target error) {
+	if !errors.Is(err, target) {
+		zaplog.ZAPS.Skip1.LOG.Panic("ERROR MISMATCH(NOT SAME ERROR)", zap.String("type", fmt.Sprintf("%T", err)), zap.String("target_type", fmt.Sprintf("%T", target))) // not show message in the log
+	}
+}
+
+// Ok expects a non-zero value. Panics if the value is zero or a typed nil.
+// Ok 期望一个非零值。如果值为零或为类型化 nil，则触发 panic。
+func Ok[V comparable](a V, opts ...Redaction) {
+	if a == utils.Zero[V]() {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", redact("a", a, opts)) // only show redacted data in the log message
+	}
+	if utils.IsTypedNil(a) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", a)))
+	}
+}
+
+// OK expects a non-zero value. Panics if the value is zero or a typed nil. Provides an alternative name based on preference.
+// OK 期望一个非零值。如果值为零或为类型化 nil，则触发 panic。提供一个偏好的替代名称。
+func OK[V comparable](a V, opts ...Redaction) {
+	if a == utils.Zero[V]() {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", redact("a", a, opts)) // only show redacted data in the log message
+	}
+	if utils.IsTypedNil(a) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", a)))
+	}
+}
+
+// Cause expects an error to be present. Panics if error is nil or a typed nil (like a nil *MyError), returns the error.
+// Cause 期望存在错误。如果错误为 nil 或为类型化 nil（例如 nil *MyError），则触发 panic；否则返回该错误。
+func Cause(err error) error {
+	if err == nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("ERROR ABSENT(SHOULD BE PRESENT)")
+	}
+	if utils.IsTypedNil(err) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", err)))
+	}
+	return err
+}
+
+// Wrong expects an error to be present. Panics if error is nil or a typed nil.
+// Wrong 期望存在错误。如果错误为 nil 或为类型化 nil，则触发 panic。
+func Wrong(err error) {
+	if err == nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("ERROR ABSENT(SHOULD BE PRESENT)")
+	}
+	if utils.IsTypedNil(err) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPED NIL(INTERFACE HOLDS NIL VALUE)", zap.String("type", fmt.Sprintf("%T", err)))
+	}
+}
+
+// Have checks that the slice is not vacant. Panics if the slice is vacant.
+// Have 检查切片是否为空。如果切片为空，则触发 panic。
+func Have[T any](a []T) []T {
+	if len(a) == 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("SLICE IS EMPTY(SHOULD HAVE ITEMS)")
+	}
+	return a
+}
+
+// Length expects the slice to have length n. Panics if the length is not n.
+// Length 期望切片的长度为 n。如果长度不是 n，则触发 panic。
+func Length[T any](a []T, n int) {
+	if len(a) != n {
+		zaplog.ZAPS.Skip1.LOG.Panic("LENGTH MISMATCH(NOT MATCH)", zap.Int("len", len(a)), zap.Int("n", n))
+	}
+}
+
+// Len is an abbreviation of Length, serving the same purpose. Panics if the length is not n.
+// Len 是 Length 的缩写，功能相同。如果长度不是 n，则触发 panic。
+func Len[T any](a []T, n int) {
+	if len(a) != n {
+		zaplog.ZAPS.Skip1.LOG.Panic("LENGTH MISMATCH(NOT MATCH)", zap.Int("len", len(a)), zap.Int("n", n))
+	}
+}
+
+// In checks if the value is in the slice. Panics if the value is not found.
+// In 检查值是否在切片中。如果未找到该值，则触发 panic。
+func In[T comparable](v T, a []T, opts ...Redaction) {
+	if !slices.Contains(a, v) {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Int("len", len(a)), redact("v", v, opts)) // only show redacted data in the log message
+	}
+}
+
+// Contains checks if the slice contains the value. Panics if the value is not found.
+// Contains 检查切片是否包含该值。如果未找到该值，则触发 panic。
+func Contains[T comparable](a []T, v T, opts ...Redaction) {
+	if !slices.Contains(a, v) {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Int("len", len(a)), redact("v", v, opts)) // only show redacted data in the log message
+	}
+}
@@ -0,0 +1,96 @@
+package mustsecret
+
+import (
+	"fmt"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// Get gets the value of the key from the map. If the key does not exist, it panics without logging the key unless a redaction is given or set.
+// Get 从 map 中获取键对应的值，如果键不存在，则触发 panic，除非传入或设置了脱敏方式，否则不记录键。
+func Get[K comparable, V any](a map[K]V, key K, opts ...Redaction) V {
+	value, exists := a[key]
+	if !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", zap.Int("len", len(a)), redact("key", key, opts)) // only show redacted data in the log message
+	}
+	return value
+}
+
+// GetNice gets the value of the key from the map. If the key does not exist or the value is zero, it panics.
+// GetNice 从 map 中获取键对应的值，如果键不存在或值为零，则触发 panic。
+func GetNice[K, V comparable](a map[K]V, key K, opts ...Redaction) V {
+	value, exists := a[key]
+	if !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", zap.Int("len", len(a)), redact("key", key, opts)) // only show redacted data in the log message
+	}
+	if value == utils.Zero[V]() {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE IS ZERO(SHOULD BE NON-ZERO)", redact("key", key, opts)) // only show redacted data in the log message
+	}
+	return value
+}
+
+// GetAs gets the value of the key from the map and asserts it as type T. If the key does not exist or the type mismatches, it panics.
+// GetAs 从 map 中获取键对应的值并断言为类型 T，如果键不存在或类型不匹配，则触发 panic。
+func GetAs[T any, K comparable](a map[K]any, key K, opts ...Redaction) T {
+	value, exists := a[key]
+	if !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", zap.Int("len", len(a)), redact("key", key, opts)) // only show redacted data in the log message
+	}
+	res, ok := value.(T)
+	if !ok {
+		zaplog.ZAPS.Skip1.LOG.Panic("TYPE MISMATCH(NOT MATCH)", zap.String("type", fmt.Sprintf("%T", value)), redact("key", key, opts)) // type is not sensitive, only show redacted key in the log message
+	}
+	return res
+}
+
+// HasKey checks if the key exists in the map. If not, it panics.
+// HasKey 检查键是否存在于 map 中，如果不存在，则触发 panic。
+func HasKey[K comparable, V any](a map[K]V, key K, opts ...Redaction) {
+	if _, exists := a[key]; !exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY NOT IN MAP(SHOULD BE IN)", zap.Int("len", len(a)), redact("key", key, opts)) // only show redacted data in the log message
+	}
+}
+
+// NotHasKey checks if the key is absent from the map. If present, it panics.
+// NotHasKey 检查键是否不在 map 中，如果存在，则触发 panic。
+func NotHasKey[K comparable, V any](a map[K]V, key K, opts ...Redaction) {
+	if _, exists := a[key]; exists {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEY IN MAP(SHOULD NOT BE IN)", zap.Int("len", len(a)), redact("key", key, opts)) // only show redacted data in the log message
+	}
+}
+
+// HasKeys checks if each of the keys exists in the map. If any is missing, it panics with the indexes of the missing keys.
+// HasKeys 检查所有键是否都存在于 map 中，如果有缺失，则触发 panic 并记录缺失键的索引。
+func HasKeys[K comparable, V any](a map[K]V, keys ...K) {
+	if missing := utils.MissingKeys(a, keys); len(missing) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEYS NOT IN MAP(SHOULD BE IN)", zap.Ints("missing_indexes", missing), zap.Int("len", len(a)), redactAll("missing_keys", utils.Pick(keys, missing), nil)) // only show redacted data in the log message
+	}
+}
+
+// KeysExactly checks if the map keys are exactly the given keys. If not, it panics with the count of missing and unexpected keys.
+// KeysExactly 检查 map 的键是否恰好是给定的键，如果不是，则触发 panic 并记录缺失和多余键的数量。
+func KeysExactly[K comparable, V any](a map[K]V, keys ...K) {
+	missing := utils.MissingKeys(a, keys)
+	unexpected := utils.UnexpectedKeys(a, keys)
+	if len(missing) > 0 || len(unexpected) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("KEYS MISMATCH(NOT MATCH)", zap.Ints("missing_indexes", missing), zap.Int("unexpected", len(unexpected)), zap.Int("len", len(a)), redactAll("missing_keys", utils.Pick(keys, missing), nil), redactAll("unexpected_keys", unexpected, nil)) // only show redacted data in the log message
+	}
+}
+
+// SubMap checks if each entry of sub is present in the map with a matching value. If not, it panics with the count of missing and mismatched entries.
+// SubMap 检查 sub 的每个条目是否都以相同的值存在于 map 中，如果不是，则触发 panic 并记录缺失和值不同的条目数量。
+func SubMap[K, V comparable](sub, a map[K]V, opts ...Redaction) {
+	if missing, mismatch := utils.SubMapDiff(sub, a); len(missing) > 0 || len(mismatch) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SUB MAP(SHOULD BE SUB MAP)", zap.Int("missing", len(missing)), zap.Int("mismatch", len(mismatch)), zap.Int("len_sub", len(sub)), zap.Int("len", len(a)), redactAll("missing_keys", missing, opts), redactAll("mismatch_keys", mismatch, opts)) // only show redacted data in the log message
+	}
+}
+
+// ValuesIn checks if each value of the map is one of the given values. If not, it panics with the count of unexpected values.
+// ValuesIn 检查 map 的每个值是否都是给定值之一，如果不是，则触发 panic 并记录意外值的数量。
+func ValuesIn[K, V comparable](a map[K]V, values ...V) {
+	if unexpected := utils.UnexpectedValueKeys(a, values); len(unexpected) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT IN SET(SHOULD BE IN)", zap.Int("unexpected", len(unexpected)), zap.Int("len", len(a)), redactAll("unexpected_keys", unexpected, nil)) // only show redacted data in the log message
+	}
+}
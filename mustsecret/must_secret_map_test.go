@@ -0,0 +1,140 @@
+package mustsecret_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustsecret"
+)
+
+// TestGet tests secret map key lookup
+// Validates Get returns the value when key exists and panics when key not found
+//
+// TestGet 测试秘密 map 键查找
+// 验证 Get 在键存在时返回值，在键不存在时 panic
+func TestGet(t *testing.T) {
+	tokens := map[string][]byte{"svc": []byte("abc")}
+	require.Equal(t, []byte("abc"), mustsecret.Get(tokens, "svc"))
+
+	require.Panics(t, func() {
+		mustsecret.Get(tokens, "other")
+	})
+}
+
+// TestGetNice tests secret map key lookup with non-zero value check
+// Validates GetNice returns non-zero values and panics when key not found / value is zero
+//
+// TestGetNice 测试秘密 map 键查找并检查非零值
+// 验证 GetNice 返回非零值，在键不存在/值为零时 panic
+func TestGetNice(t *testing.T) {
+	tokens := map[string]string{"a": "x", "b": ""}
+	require.Equal(t, "x", mustsecret.GetNice(tokens, "a"))
+
+	require.Panics(t, func() {
+		mustsecret.GetNice(tokens, "b")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.GetNice(tokens, "c")
+	})
+}
+
+// TestGetAs tests secret map key lookup with type assertion
+// Validates GetAs returns typed values and panics when key not found / type mismatches
+//
+// TestGetAs 测试秘密 map 键查找并进行类型断言
+// 验证 GetAs 返回类型化的值，在键不存在/类型不匹配时 panic
+func TestGetAs(t *testing.T) {
+	data := map[string]any{"password": "abc", "pin": 1234}
+	require.Equal(t, "abc", mustsecret.GetAs[string](data, "password"))
+
+	require.Panics(t, func() {
+		mustsecret.GetAs[string](data, "pin")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.GetAs[string](data, "missing")
+	})
+}
+
+// TestHasKey tests secret map key presence / absence assertions
+// Validates HasKey / NotHasKey pass and panic as the key presence requires
+//
+// TestHasKey 测试秘密 map 键存在/不存在断言
+// 验证 HasKey / NotHasKey 根据键是否存在通过或 panic
+func TestHasKey(t *testing.T) {
+	tokens := map[string]string{"a": "x"}
+	mustsecret.HasKey(tokens, "a")
+	mustsecret.NotHasKey(tokens, "b")
+
+	require.Panics(t, func() {
+		mustsecret.HasKey(tokens, "b")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.NotHasKey(tokens, "a")
+	})
+}
+
+// TestHasKeys tests multiple secret map keys presence assertion
+// Validates HasKeys passes when each key exists and panics when any key is missing
+//
+// TestHasKeys 测试多个秘密 map 键存在断言
+// 验证 HasKeys 在所有键都存在时通过，在有键缺失时 panic
+func TestHasKeys(t *testing.T) {
+	tokens := map[string]string{"a": "x", "b": "y"}
+	mustsecret.HasKeys(tokens, "a", "b")
+
+	require.Panics(t, func() {
+		mustsecret.HasKeys(tokens, "a", "c")
+	})
+}
+
+// TestKeysExactly tests exact secret map key set assertion
+// Validates KeysExactly passes when key sets match and panics on missing / unexpected keys
+//
+// TestKeysExactly 测试秘密 map 键集合精确匹配断言
+// 验证 KeysExactly 在键集合一致时通过，在有缺失/多余键时 panic
+func TestKeysExactly(t *testing.T) {
+	tokens := map[string]string{"a": "x", "b": "y"}
+	mustsecret.KeysExactly(tokens, "b", "a")
+
+	require.Panics(t, func() {
+		mustsecret.KeysExactly(tokens, "a")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.KeysExactly(tokens, "a", "b", "c")
+	})
+}
+
+// TestSubMap tests secret sub map assertion
+// Validates SubMap passes when each entry is present with same value and panics otherwise
+//
+// TestSubMap 测试秘密子 map 断言
+// 验证 SubMap 在每个条目都以相同值存在时通过，否则 panic
+func TestSubMap(t *testing.T) {
+	tokens := map[string]string{"a": "x", "b": "y"}
+	mustsecret.SubMap(map[string]string{"a": "x"}, tokens)
+
+	require.Panics(t, func() {
+		mustsecret.SubMap(map[string]string{"a": "z"}, tokens)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.SubMap(map[string]string{"c": "x"}, tokens)
+	})
+}
+
+// TestValuesIn tests secret map values membership assertion
+// Validates ValuesIn passes when each value is allowed and panics when a value is unexpected
+//
+// TestValuesIn 测试秘密 map 值成员断言
+// 验证 ValuesIn 在每个值都被允许时通过，在有意外值时 panic
+func TestValuesIn(t *testing.T) {
+	mustsecret.ValuesIn(map[string]string{"a": "x", "b": "y"}, "x", "y")
+
+	require.Panics(t, func() {
+		mustsecret.ValuesIn(map[string]string{"a": "x", "b": "z"}, "x", "y")
+	})
+}
@@ -0,0 +1,62 @@
+package mustsecret
+
+import (
+	"github.com/yyle88/must/mustnum"
+	"github.com/yyle88/zaplog"
+)
+
+// Less validates that a is less than b. Panics if a >= b.
+// Less 验证 a 小于 b。如果 a >= b 则触发 panic。
+func Less[V mustnum.Num](a, b V, opts ...Redaction) {
+	if a >= b {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT LESS THAN(SHOULD BE LESS)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// Lt validates that a is less than b. Alias of Less function. Panics if a >= b.
+// Lt 验证 a 小于 b。Less 函数的别名。如果 a >= b 则触发 panic。
+func Lt[V mustnum.Num](a, b V, opts ...Redaction) {
+	if a >= b {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT LESS THAN(SHOULD BE LESS)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// Lte validates that a is less than / at most b. Panics if a > b.
+// Lte 验证 a 小于或等于 b。如果 a > b 则触发 panic。
+func Lte[V mustnum.Num](a, b V, opts ...Redaction) {
+	if a > b {
+		zaplog.ZAPS.Skip1.LOG.Panic("GREATER THAN(SHOULD BE LESS OR SAME)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// Gt validates that a exceeds b. Panics if a <= b.
+// Gt 验证 a 大于 b。如果 a <= b 则触发 panic。
+func Gt[V mustnum.Num](a, b V, opts ...Redaction) {
+	if a <= b {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT GREATER THAN(SHOULD BE GREATER)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// Gte validates that a exceeds / matches b. Panics if a < b.
+// Gte 验证 a 大于或等于 b。如果 a < b 则触发 panic。
+func Gte[V mustnum.Num](a, b V, opts ...Redaction) {
+	if a < b {
+		zaplog.ZAPS.Skip1.LOG.Panic("LESS THAN(SHOULD BE GREATER OR SAME)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// Positive validates that value exceeds zero. Panics if value <= 0.
+// Positive 验证值严格大于零。如果值 <= 0 则触发 panic。
+func Positive[V mustnum.Num](v V, opts ...Redaction) {
+	if v <= 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT POSITIVE(SHOULD BE POSITIVE)", redact("v", v, opts)) // only show redacted data in the log message
+	}
+}
+
+// Negative validates that value is below zero. Panics if value >= 0.
+// Negative 验证值严格小于零。如果值 >= 0 则触发 panic。
+func Negative[V mustnum.Num](v V, opts ...Redaction) {
+	if v >= 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT NEGATIVE(SHOULD BE NEGATIVE)", redact("v", v, opts)) // only show redacted data in the log message
+	}
+}
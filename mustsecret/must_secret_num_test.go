@@ -0,0 +1,67 @@
+package mustsecret_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustsecret"
+)
+
+// TestLess tests secret numeric less-than assertions
+// Validates Less / Lt / Lte pass when ordered and panic when not
+//
+// TestLess 测试秘密数值小于断言
+// 验证 Less / Lt / Lte 在有序时通过，否则 panic
+func TestLess(t *testing.T) {
+	mustsecret.Less(1, 2)
+	mustsecret.Lt(1.5, 2.5)
+	mustsecret.Lte(2, 2)
+
+	require.Panics(t, func() {
+		mustsecret.Less(2, 2)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Lt(3, 2)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Lte(3, 2)
+	})
+}
+
+// TestGt tests secret numeric greater-than assertions
+// Validates Gt / Gte pass when ordered and panic when not
+//
+// TestGt 测试秘密数值大于断言
+// 验证 Gt / Gte 在有序时通过，否则 panic
+func TestGt(t *testing.T) {
+	mustsecret.Gt(uint8(2), uint8(1))
+	mustsecret.Gte(2, 2)
+
+	require.Panics(t, func() {
+		mustsecret.Gt(2, 2)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Gte(1, 2)
+	})
+}
+
+// TestPositive tests secret numeric sign assertions
+// Validates Positive / Negative pass with matching signs and panic otherwise
+//
+// TestPositive 测试秘密数值符号断言
+// 验证 Positive / Negative 在符号匹配时通过，否则 panic
+func TestPositive(t *testing.T) {
+	mustsecret.Positive(1)
+	mustsecret.Negative(-0.5)
+
+	require.Panics(t, func() {
+		mustsecret.Positive(0)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Negative(0)
+	})
+}
@@ -0,0 +1,139 @@
+package mustsecret
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+)
+
+// Redaction renders a secret value into a log-safe representation, an empty result omits the field
+// Redaction 将机密值渲染为可安全记录的表示形式，返回空字符串表示省略该字段
+type Redaction func(secret string) string
+
+// RedactOmit omits the secret from the log entirely. It is the default redaction.
+// RedactOmit 完全不在日志中记录机密。这是默认的脱敏方式。
+func RedactOmit(secret string) string {
+	return ""
+}
+
+// RedactLength logs only the length of the secret, like "len=12".
+// RedactLength 只记录机密的长度，例如 "len=12"。
+func RedactLength(secret string) string {
+	return "len=" + strconv.Itoa(len(secret))
+}
+
+// RedactMask keeps the leading prefix and trailing suffix runes and masks the rest, like "sk-****abcd".
+// When the secret is too short to keep both parts, the whole secret is masked. Negative counts are treated as 0.
+//
+// RedactMask 保留开头 prefix 个和结尾 suffix 个字符并遮盖其余部分，例如 "sk-****abcd"。
+// 当机密太短而无法同时保留两部分时，整个机密都会被遮盖。负数按 0 处理。
+func RedactMask(prefix, suffix int) Redaction {
+	prefix, suffix = max(prefix, 0), max(suffix, 0)
+	return func(secret string) string {
+		runes := []rune(secret)
+		if len(runes) <= prefix+suffix {
+			return "****"
+		}
+		return string(runes[:prefix]) + "****" + string(runes[len(runes)-suffix:])
+	}
+}
+
+// RedactFingerprint logs a salted SHA-256 fingerprint, like "sha256:1a2b3c4d5e6f7a8b", telling whether two secrets match without exposing them.
+// Use a per-process random salt so the fingerprints cannot be looked up in precomputed tables.
+//
+// RedactFingerprint 记录加盐的 SHA-256 指纹，例如 "sha256:1a2b3c4d5e6f7a8b"，无需暴露即可判断两个机密是否相同。
+// 请使用进程级的随机盐，使指纹无法通过预计算表查出。
+func RedactFingerprint(salt []byte) Redaction {
+	return func(secret string) string {
+		hash := sha256.New()
+		hash.Write(salt)
+		hash.Write([]byte(secret))
+		return "sha256:" + hex.EncodeToString(hash.Sum(nil))[:16]
+	}
+}
+
+// redaction holds the package-wide redaction, nil means RedactOmit
+// redaction 保存包级的脱敏方式，nil 表示 RedactOmit
+var redaction atomic.Pointer[Redaction]
+
+// SetRedaction sets the package-wide redaction used when no redaction is passed to the assertion, nil restores RedactOmit.
+// SetRedaction 设置包级的脱敏方式，在断言未传入脱敏方式时使用，传 nil 恢复为 RedactOmit。
+func SetRedaction(r Redaction) {
+	if r == nil {
+		redaction.Store(nil)
+		return
+	}
+	redaction.Store(&r)
+}
+
+// currentRedaction returns the package-wide redaction, nil when omitting
+// currentRedaction 返回包级的脱敏方式，省略时返回 nil
+func currentRedaction() Redaction {
+	if p := redaction.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// pickRedaction returns the last given redaction, else the package-wide one, nil when omitting
+// pickRedaction 返回最后传入的脱敏方式，否则返回包级脱敏方式，省略时返回 nil
+func pickRedaction(opts []Redaction) Redaction {
+	if len(opts) > 0 {
+		return opts[len(opts)-1]
+	}
+	return currentRedaction()
+}
+
+// redact renders the secret into a zap field using the last given redaction, else the package-wide one
+// Returns zap.Skip when the redaction omits the secret
+//
+// redact 使用最后传入的脱敏方式（否则使用包级脱敏方式）将机密渲染为 zap 字段
+// 当脱敏方式省略机密时返回 zap.Skip
+func redact(key string, v any, opts []Redaction) zap.Field {
+	r := pickRedaction(opts)
+	if r == nil {
+		return zap.Skip()
+	}
+	if res := r(secretText(v)); res != "" {
+		return zap.String(key, res)
+	}
+	return zap.Skip()
+}
+
+// redactAll renders each secret using the last given redaction, else the package-wide one
+// Returns zap.Skip when the redaction omits the secrets
+//
+// redactAll 使用最后传入的脱敏方式（否则使用包级脱敏方式）渲染每个机密
+// 当脱敏方式省略机密时返回 zap.Skip
+func redactAll[T any](key string, vs []T, opts []Redaction) zap.Field {
+	r := pickRedaction(opts)
+	if r == nil || len(vs) == 0 {
+		return zap.Skip()
+	}
+	res := make([]string, 0, len(vs))
+	for _, v := range vs {
+		res = append(res, r(secretText(v)))
+	}
+	if strings.Join(res, "") == "" {
+		return zap.Skip()
+	}
+	return zap.Strings(key, res)
+}
+
+// secretText returns the text of the secret passed to the redaction
+// secretText 返回传给脱敏方式的机密文本
+func secretText(v any) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case []byte:
+		return string(x)
+	default:
+		return fmt.Sprint(x)
+	}
+}
@@ -0,0 +1,176 @@
+package mustsecret_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/internal/tests"
+	"github.com/yyle88/must/mustsecret"
+)
+
+// TestRedactOmit tests the default redaction omitting the secret
+// Validates no value field is logged when the assertion fails
+//
+// TestRedactOmit 测试默认省略机密的脱敏方式
+// 验证断言失败时不记录值字段
+func TestRedactOmit(t *testing.T) {
+	require.Equal(t, "", mustsecret.RedactOmit("sk-abc"))
+
+	logs := tests.ObserveLogs(t)
+	require.Panics(t, func() {
+		mustsecret.Same("sk-abc", "sk-xyz")
+	})
+	require.NotContains(t, logs.All()[0].ContextMap(), "a")
+	require.NotContains(t, logs.All()[0].ContextMap(), "b")
+}
+
+// TestRedactLength tests the length-only redaction
+// Validates only the length of the secret is rendered
+//
+// TestRedactLength 测试只记录长度的脱敏方式
+// 验证只渲染机密的长度
+func TestRedactLength(t *testing.T) {
+	require.Equal(t, "len=6", mustsecret.RedactLength("sk-abc"))
+	require.Equal(t, "len=0", mustsecret.RedactLength(""))
+}
+
+// TestRedactMask tests the masked redaction keeping the leading / trailing runes
+// Validates short secrets are masked entirely and negative counts are treated as 0
+//
+// TestRedactMask 测试保留开头/结尾字符的遮盖脱敏方式
+// 验证过短的机密被完全遮盖，负数按 0 处理
+func TestRedactMask(t *testing.T) {
+	require.Equal(t, "sk-****abcd", mustsecret.RedactMask(3, 4)("sk-live-0123abcd"))
+	require.Equal(t, "****cd", mustsecret.RedactMask(0, 2)("abcd"))
+	require.Equal(t, "****", mustsecret.RedactMask(3, 4)("sk-abcd"))
+	require.Equal(t, "密****码", mustsecret.RedactMask(1, 1)("密钥密码"))
+	require.Equal(t, "****abcd", mustsecret.RedactMask(-3, 4)("sk-live-0123abcd"))
+	require.Equal(t, "sk-****", mustsecret.RedactMask(3, -1)("sk-live-0123abcd"))
+	require.Equal(t, "****", mustsecret.RedactMask(-1, -1)("sk"))
+}
+
+// TestRedactFingerprint tests the salted SHA-256 fingerprint redaction
+// Validates same secrets give same fingerprints and different salts give different fingerprints
+//
+// TestRedactFingerprint 测试加盐 SHA-256 指纹脱敏方式
+// 验证相同机密得到相同指纹，不同盐得到不同指纹
+func TestRedactFingerprint(t *testing.T) {
+	fingerprint := mustsecret.RedactFingerprint([]byte("salt"))
+	require.Equal(t, fingerprint("sk-abc"), fingerprint("sk-abc"))
+	require.NotEqual(t, fingerprint("sk-abc"), fingerprint("sk-abd"))
+	require.NotEqual(t, fingerprint("sk-abc"), mustsecret.RedactFingerprint([]byte("pepper"))("sk-abc"))
+	require.Regexp(t, `^sha256:[0-9a-f]{16}$`, fingerprint("sk-abc"))
+	require.NotContains(t, fingerprint("sk-abc"), "sk-abc")
+}
+
+// TestSetRedaction tests the package-wide redaction
+// Validates the redaction applies to value, slice and map assertions, and nil restores omitting
+//
+// TestSetRedaction 测试包级脱敏方式
+// 验证脱敏方式作用于值、切片和 map 断言，传 nil 恢复为省略
+func TestSetRedaction(t *testing.T) {
+	logs := tests.ObserveLogs(t)
+	fingerprint := mustsecret.RedactFingerprint([]byte("salt"))
+	mustsecret.SetRedaction(fingerprint)
+	defer mustsecret.SetRedaction(nil)
+
+	require.Panics(t, func() {
+		mustsecret.SameConstTime("sk-abc", "sk-abd")
+	})
+	fields := logs.All()[0].ContextMap()
+	require.Equal(t, fingerprint("sk-abc"), fields["a"])
+	require.Equal(t, fingerprint("sk-abd"), fields["b"])
+
+	require.Panics(t, func() {
+		mustsecret.OneOf("sk-abc", "x", "y")
+	})
+	fields = logs.All()[1].ContextMap()
+	require.Equal(t, fingerprint("sk-abc"), fields["string"])
+	require.Equal(t, []any{fingerprint("x"), fingerprint("y")}, fields["candidate_values"])
+
+	require.Panics(t, func() {
+		mustsecret.Get(map[string]int{"a": 1}, "sk-abc")
+	})
+	fields = logs.All()[2].ContextMap()
+	require.Equal(t, fingerprint("sk-abc"), fields["key"])
+
+	require.Panics(t, func() {
+		mustsecret.Unique([]string{"sk-abc", "sk-abd", "sk-abc"})
+	})
+	fields = logs.All()[3].ContextMap()
+	require.Equal(t, []any{fingerprint("sk-abc")}, fields["duplicate_values"])
+
+	mustsecret.SetRedaction(nil)
+	require.Panics(t, func() {
+		mustsecret.Same("sk-abc", "sk-abd")
+	})
+	require.NotContains(t, logs.All()[4].ContextMap(), "a")
+}
+
+// TestSetRedaction_Mask tests the package-wide masked redaction
+// Validates the masked values are logged in the value fields
+//
+// TestSetRedaction_Mask 测试包级遮盖脱敏方式
+// 验证遮盖后的值被记录在值字段中
+func TestSetRedaction_Mask(t *testing.T) {
+	logs := tests.ObserveLogs(t)
+	mustsecret.SetRedaction(mustsecret.RedactMask(3, 4))
+	defer mustsecret.SetRedaction(nil)
+
+	require.Panics(t, func() {
+		mustsecret.Same("sk-live-0123abcd", "sk-live-9999wxyz")
+	})
+	fields := logs.All()[0].ContextMap()
+	require.Equal(t, "sk-****abcd", fields["a"])
+	require.Equal(t, "sk-****wxyz", fields["b"])
+
+	require.Panics(t, func() {
+		mustsecret.HasKeys(map[string]int{"a": 1}, "a", "sk-live-0123abcd")
+	})
+	fields = logs.All()[1].ContextMap()
+	require.Equal(t, []any{"sk-****abcd"}, fields["missing_keys"])
+}
+
+// TestRedaction_PerCall tests the redaction passed to a single assertion
+// Validates the passed redaction applies without a package-wide one, overrides the package-wide one, and RedactOmit omits the values
+//
+// TestRedaction_PerCall 测试传给单个断言的脱敏方式
+// 验证传入的脱敏方式在没有包级脱敏方式时生效、会覆盖包级脱敏方式，且 RedactOmit 省略值
+func TestRedaction_PerCall(t *testing.T) {
+	logs := tests.ObserveLogs(t)
+
+	require.Panics(t, func() {
+		mustsecret.Same("sk-abc", "sk-abcd", mustsecret.RedactLength)
+	})
+	fields := logs.All()[0].ContextMap()
+	require.Equal(t, "len=6", fields["a"])
+	require.Equal(t, "len=7", fields["b"])
+
+	require.Panics(t, func() {
+		mustsecret.Unique([]string{"sk-abc", "sk-abc"}, mustsecret.RedactMask(3, 0))
+	})
+	fields = logs.All()[1].ContextMap()
+	require.Equal(t, []any{"sk-****"}, fields["duplicate_values"])
+
+	mustsecret.SetRedaction(mustsecret.RedactLength)
+	defer mustsecret.SetRedaction(nil)
+
+	require.Panics(t, func() {
+		mustsecret.Get(map[string]int{"a": 1}, "sk-abc", mustsecret.RedactMask(3, 0))
+	})
+	fields = logs.All()[2].ContextMap()
+	require.Equal(t, "sk-****", fields["key"])
+
+	require.Panics(t, func() {
+		mustsecret.HasPrefix("sk-abc", "pk-", mustsecret.RedactOmit)
+	})
+	require.NotContains(t, logs.All()[3].ContextMap(), "string")
+	require.NotContains(t, logs.All()[3].ContextMap(), "prefix")
+
+	require.Panics(t, func() {
+		mustsecret.HasPrefix("sk-abc", "pk-")
+	})
+	fields = logs.All()[4].ContextMap()
+	require.Equal(t, "len=6", fields["string"])
+	require.Equal(t, "len=3", fields["prefix"])
+}
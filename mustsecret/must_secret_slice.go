@@ -0,0 +1,110 @@
+package mustsecret
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// All checks if each element satisfies the predicate, panics with the index of the first offending element if not.
+// All 检查每个元素是否都满足断言函数，不满足则触发 panic 并记录第一个不满足元素的索引。
+func All[T any](a []T, pred func(T) bool, opts ...Redaction) {
+	for idx, v := range a {
+		if !pred(v) {
+			zaplog.ZAPS.Skip1.LOG.Panic("ELEMENT NOT MATCH(SHOULD ALL MATCH)", zap.Int("index", idx), zap.Int("len", len(a)), redact("v", v, opts)) // only show redacted data in the log message
+		}
+	}
+}
+
+// NoneMatch checks if no element satisfies the predicate, panics with the index of the first matching element if any.
+// NoneMatch 检查是否没有元素满足断言函数，有则触发 panic 并记录第一个满足元素的索引。
+func NoneMatch[T any](a []T, pred func(T) bool, opts ...Redaction) {
+	for idx, v := range a {
+		if pred(v) {
+			zaplog.ZAPS.Skip1.LOG.Panic("ELEMENT MATCH(SHOULD NONE MATCH)", zap.Int("index", idx), zap.Int("len", len(a)), redact("v", v, opts)) // only show redacted data in the log message
+		}
+	}
+}
+
+// EqualsFunc checks if two slices match element-wise by the eq function, panics with the first mismatch index if not.
+// EqualsFunc 使用 eq 函数逐个元素检查两个切片是否相等，不相等则触发 panic 并记录第一个不匹配的索引。
+func EqualsFunc[T1, T2 any](a []T1, b []T2, eq func(T1, T2) bool, opts ...Redaction) {
+	if len(a) != len(b) {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SAME(SHOULD BE SAME)", zap.Int("len_a", len(a)), zap.Int("len_b", len(b)))
+	}
+	for idx := range a {
+		if !eq(a[idx], b[idx]) {
+			zaplog.ZAPS.Skip1.LOG.Panic("NOT SAME(SHOULD BE SAME)", zap.Int("index", idx), zap.Int("len", len(a)), redact("a", a[idx], opts), redact("b", b[idx], opts)) // only show redacted data in the log message
+		}
+	}
+}
+
+// Unique checks if the slice has no duplicate elements, panics with the index of the first repetition of each duplicate if any.
+// Unique 检查切片是否没有重复元素，有重复则触发 panic 并记录每个重复元素首次重复出现的索引。
+func Unique[T comparable](a []T, opts ...Redaction) []T {
+	if duplicates := utils.DuplicateIndexes(a); len(duplicates) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("HAS DUPLICATES(SHOULD BE UNIQUE)", zap.Ints("duplicate_indexes", duplicates), zap.Int("len", len(a)), redactAll("duplicate_values", utils.Pick(a, duplicates), opts)) // only show redacted data in the log message
+	}
+	return a
+}
+
+// ElementsMatch checks if two slices contain the same elements with the same counts ignoring order, panics if not.
+// ElementsMatch 检查两个切片在忽略顺序时是否包含相同元素且数量一致，不一致则触发 panic。
+func ElementsMatch[T comparable](a, b []T, opts ...Redaction) {
+	if missing, extra := utils.ElementsDiff(a, b); len(missing) > 0 || len(extra) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("ELEMENTS NOT MATCH(SHOULD MATCH)", zap.Int("missing", len(missing)), zap.Int("extra", len(extra)), zap.Int("len_a", len(a)), zap.Int("len_b", len(b)), redactAll("missing_values", utils.Pick(a, missing), opts), redactAll("extra_values", utils.Pick(b, extra), opts)) // only show redacted data in the log message
+	}
+}
+
+// Subset checks if each element of sub is in a, panics with the indexes of missing elements in sub if not.
+// Subset 检查 sub 的每个元素是否都在 a 中，不在则触发 panic 并记录缺失元素在 sub 中的索引。
+func Subset[T comparable](sub, a []T, opts ...Redaction) {
+	if missing := utils.DifferenceIndexes(sub, a); len(missing) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SUBSET(SHOULD BE SUBSET)", zap.Ints("missing_indexes", missing), zap.Int("len_sub", len(sub)), zap.Int("len", len(a)), redactAll("missing_values", utils.Pick(sub, missing), opts)) // only show redacted data in the log message
+	}
+}
+
+// Superset checks if a contains each element of sub, panics with the indexes of missing elements in sub if not.
+// Superset 检查 a 是否包含 sub 的每个元素，不包含则触发 panic 并记录缺失元素在 sub 中的索引。
+func Superset[T comparable](a, sub []T, opts ...Redaction) {
+	if missing := utils.DifferenceIndexes(sub, a); len(missing) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SUPERSET(SHOULD BE SUPERSET)", zap.Ints("missing_indexes", missing), zap.Int("len", len(a)), zap.Int("len_sub", len(sub)), redactAll("missing_values", utils.Pick(sub, missing), opts)) // only show redacted data in the log message
+	}
+}
+
+// Disjoint checks if two slices share no elements, panics with the indexes of common elements in a if any.
+// Disjoint 检查两个切片是否没有共同元素，有则触发 panic 并记录共同元素在 a 中的索引。
+func Disjoint[T comparable](a, b []T, opts ...Redaction) {
+	if common := utils.CommonIndexes(a, b); len(common) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("HAS COMMON ELEMENTS(SHOULD BE DISJOINT)", zap.Ints("common_indexes", common), zap.Int("len_a", len(a)), zap.Int("len_b", len(b)), redactAll("common_values", utils.Pick(a, common), opts)) // only show redacted data in the log message
+	}
+}
+
+// SortedFunc checks if the slice is sorted in ascending order by the compare function, panics with the first out-of-order index if not.
+// SortedFunc 检查切片是否按 compare 函数升序排列，不是则触发 panic 并记录第一个乱序的索引。
+func SortedFunc[T any](a []T, compare func(x, y T) int, opts ...Redaction) {
+	if idx := utils.UnsortedIndex(a, compare); idx >= 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SORTED(SHOULD BE SORTED)", zap.Int("index", idx), zap.Int("len", len(a)), redact("prev", a[idx-1], opts), redact("next", a[idx], opts)) // only show redacted data in the log message
+	}
+}
+
+// IsSortedBy checks if the slice is sorted in ascending order by the key, panics with the first out-of-order index if not.
+// IsSortedBy 检查切片是否按 key 升序排列，不是则触发 panic 并记录第一个乱序的索引。
+func IsSortedBy[T any, K cmp.Ordered](a []T, keyFn func(T) K, opts ...Redaction) {
+	if idx := utils.UnsortedIndex(a, func(x, y T) int { return cmp.Compare(keyFn(x), keyFn(y)) }); idx >= 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SORTED BY KEY(SHOULD BE SORTED)", zap.Int("index", idx), zap.Int("len", len(a)), redact("prev_key", keyFn(a[idx-1]), opts), redact("next_key", keyFn(a[idx]), opts)) // only show redacted data in the log message
+	}
+}
+
+// BinarySearch returns the index of v in the sorted slice, panics if v is not found.
+// BinarySearch 在已排序切片中返回 v 的索引，未找到则触发 panic。
+func BinarySearch[T cmp.Ordered](a []T, v T, opts ...Redaction) int {
+	idx, found := slices.BinarySearch(a, v)
+	if !found {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Int("len", len(a)), redact("v", v, opts)) // only show redacted data in the log message
+	}
+	return idx
+}
@@ -0,0 +1,144 @@
+package mustsecret_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustsecret"
+)
+
+// TestAll tests every-element predicate assertion on secret slices
+// Validates All passes when each element matches and panics when one element does not
+//
+// TestAll 测试秘密切片所有元素断言函数断言
+// 验证 All 在每个元素都匹配时通过，在有元素不匹配时 panic
+func TestAll(t *testing.T) {
+	isKey := func(s string) bool { return strings.HasPrefix(s, "sk-") }
+	mustsecret.All([]string{"sk-a", "sk-b"}, isKey)
+
+	require.Panics(t, func() {
+		mustsecret.All([]string{"sk-a", "pk-b"}, isKey)
+	})
+}
+
+// TestNoneMatch tests no-element predicate assertion on secret slices
+// Validates NoneMatch passes when no element matches and panics when one element matches
+//
+// TestNoneMatch 测试秘密切片无元素匹配断言
+// 验证 NoneMatch 在没有元素匹配时通过，在有元素匹配时 panic
+func TestNoneMatch(t *testing.T) {
+	isBlank := func(s string) bool { return s == "" }
+	mustsecret.NoneMatch([]string{"a", "b"}, isBlank)
+
+	require.Panics(t, func() {
+		mustsecret.NoneMatch([]string{"a", ""}, isBlank)
+	})
+}
+
+// TestEqualsFunc tests element-wise secret slice equality with custom function
+// Validates EqualsFunc passes when elements match and panics on mismatch / length difference
+//
+// TestEqualsFunc 测试使用自定义函数的秘密切片逐元素相等断言
+// 验证 EqualsFunc 在元素匹配时通过，在不匹配/长度不同时 panic
+func TestEqualsFunc(t *testing.T) {
+	eq := func(a, b []byte) bool { return slices.Equal(a, b) }
+	mustsecret.EqualsFunc([][]byte{[]byte("a")}, [][]byte{[]byte("a")}, eq)
+
+	require.Panics(t, func() {
+		mustsecret.EqualsFunc([][]byte{[]byte("a")}, [][]byte{[]byte("b")}, eq)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.EqualsFunc([][]byte{[]byte("a")}, [][]byte{}, eq)
+	})
+}
+
+// TestUnique tests no-duplicates assertion on secret slices
+// Validates Unique returns slices without duplicates and panics when duplicates exist
+//
+// TestUnique 测试秘密切片无重复断言
+// 验证 Unique 返回无重复的切片，在存在重复时 panic
+func TestUnique(t *testing.T) {
+	require.Equal(t, []string{"a", "b"}, mustsecret.Unique([]string{"a", "b"}))
+
+	require.Panics(t, func() {
+		mustsecret.Unique([]string{"a", "a"})
+	})
+}
+
+// TestElementsMatch tests multiset equality assertion on secret slices
+// Validates ElementsMatch passes when elements match ignoring order and panics otherwise
+//
+// TestElementsMatch 测试秘密切片多重集合相等断言
+// 验证 ElementsMatch 在忽略顺序元素一致时通过，否则 panic
+func TestElementsMatch(t *testing.T) {
+	mustsecret.ElementsMatch([]string{"a", "b", "b"}, []string{"b", "a", "b"})
+
+	require.Panics(t, func() {
+		mustsecret.ElementsMatch([]string{"a", "b", "b"}, []string{"a", "b"})
+	})
+}
+
+// TestSubset tests subset / superset assertions on secret slices
+// Validates Subset / Superset pass when elements are contained and panic when missing
+//
+// TestSubset 测试秘密切片子集/超集断言
+// 验证 Subset / Superset 在元素被包含时通过，在缺失时 panic
+func TestSubset(t *testing.T) {
+	mustsecret.Subset([]string{"a"}, []string{"a", "b"})
+	mustsecret.Superset([]string{"a", "b"}, []string{"b"})
+
+	require.Panics(t, func() {
+		mustsecret.Subset([]string{"c"}, []string{"a", "b"})
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Superset([]string{"a", "b"}, []string{"c"})
+	})
+}
+
+// TestDisjoint tests no-common-elements assertion on secret slices
+// Validates Disjoint passes when slices share no elements and panics when they do
+//
+// TestDisjoint 测试秘密切片无共同元素断言
+// 验证 Disjoint 在切片没有共同元素时通过，在有共同元素时 panic
+func TestDisjoint(t *testing.T) {
+	mustsecret.Disjoint([]string{"a"}, []string{"b"})
+
+	require.Panics(t, func() {
+		mustsecret.Disjoint([]string{"a", "b"}, []string{"b"})
+	})
+}
+
+// TestSortedFunc tests sortedness assertions on secret slices
+// Validates SortedFunc / IsSortedBy pass when sorted and panic on out-of-order pairs
+//
+// TestSortedFunc 测试秘密切片有序断言
+// 验证 SortedFunc / IsSortedBy 在有序时通过，在存在乱序对时 panic
+func TestSortedFunc(t *testing.T) {
+	mustsecret.SortedFunc([]string{"a", "b"}, strings.Compare)
+	mustsecret.IsSortedBy([]string{"bb", "a"}, func(s string) int { return -len(s) })
+
+	require.Panics(t, func() {
+		mustsecret.SortedFunc([]string{"b", "a"}, strings.Compare)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.IsSortedBy([]string{"a", "bb"}, func(s string) int { return -len(s) })
+	})
+}
+
+// TestBinarySearch tests sorted secret slice lookup
+// Validates BinarySearch returns the index when found and panics when missing
+//
+// TestBinarySearch 测试有序秘密切片查找
+// 验证 BinarySearch 在找到时返回索引，在缺失时 panic
+func TestBinarySearch(t *testing.T) {
+	require.Equal(t, 1, mustsecret.BinarySearch([]string{"a", "b", "c"}, "b"))
+
+	require.Panics(t, func() {
+		mustsecret.BinarySearch([]string{"a", "c"}, "b")
+	})
+}
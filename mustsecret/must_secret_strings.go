@@ -0,0 +1,121 @@
+package mustsecret
+
+import (
+	"strings"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// HasPrefix checks if the string has the specified prefix, panics if not.
+// HasPrefix 检查字符串是否有指定的前缀，没有则触发 panic。
+func HasPrefix(a string, prefix string, opts ...Redaction) {
+	if !strings.HasPrefix(a, prefix) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING PREFIX(SHOULD HAVE PREFIX)", zap.Int("len", len(a)), zap.Int("len_prefix", len(prefix)), redact("string", a, opts), redact("prefix", prefix, opts)) // only show redacted data in the log message
+	}
+}
+
+// HasSuffix checks if the string has the specified suffix, panics if not.
+// HasSuffix 检查字符串是否有指定的后缀，没有则触发 panic。
+func HasSuffix(a string, suffix string, opts ...Redaction) {
+	if !strings.HasSuffix(a, suffix) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING SUFFIX(SHOULD HAVE SUFFIX)", zap.Int("len", len(a)), zap.Int("len_suffix", len(suffix)), redact("string", a, opts), redact("suffix", suffix, opts)) // only show redacted data in the log message
+	}
+}
+
+// NotHasPrefix checks if the string does not have the specified prefix, panics if it does.
+// NotHasPrefix 检查字符串是否没有指定的前缀，有则触发 panic。
+func NotHasPrefix(a string, prefix string, opts ...Redaction) {
+	if strings.HasPrefix(a, prefix) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING HAS PREFIX(SHOULD NOT HAVE PREFIX)", zap.Int("len", len(a)), zap.Int("len_prefix", len(prefix)), redact("string", a, opts), redact("prefix", prefix, opts)) // only show redacted data in the log message
+	}
+}
+
+// NotHasSuffix checks if the string does not have the specified suffix, panics if it does.
+// NotHasSuffix 检查字符串是否没有指定的后缀，有则触发 panic。
+func NotHasSuffix(a string, suffix string, opts ...Redaction) {
+	if strings.HasSuffix(a, suffix) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING HAS SUFFIX(SHOULD NOT HAVE SUFFIX)", zap.Int("len", len(a)), zap.Int("len_suffix", len(suffix)), redact("string", a, opts), redact("suffix", suffix, opts)) // only show redacted data in the log message
+	}
+}
+
+// HasSubstring checks if the string contains the specified substring, panics if not. Mirrors muststrings.Contains.
+// HasSubstring 检查字符串是否包含指定的子串，没有则触发 panic。对应 muststrings.Contains。
+func HasSubstring(a string, sub string, opts ...Redaction) {
+	if !strings.Contains(a, sub) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING SUBSTRING(SHOULD HAVE SUBSTRING)", zap.Int("len", len(a)), zap.Int("len_substring", len(sub)), redact("string", a, opts), redact("substring", sub, opts)) // only show redacted data in the log message
+	}
+}
+
+// NotHasSubstring checks if the string does not contain the specified substring, panics if it does. Mirrors muststrings.NotContains.
+// NotHasSubstring 检查字符串是否不包含指定的子串，有则触发 panic。对应 muststrings.NotContains。
+func NotHasSubstring(a string, sub string, opts ...Redaction) {
+	if strings.Contains(a, sub) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING HAS SUBSTRING(SHOULD NOT HAVE SUBSTRING)", zap.Int("len", len(a)), zap.Int("len_substring", len(sub)), redact("string", a, opts), redact("substring", sub, opts)) // only show redacted data in the log message
+	}
+}
+
+// EqualFold checks if the two strings match ignoring case, panics if not.
+// EqualFold 检查两个字符串在忽略大小写时是否相等，不相等则触发 panic。
+func EqualFold(a string, b string, opts ...Redaction) {
+	if !strings.EqualFold(a, b) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRINGS NOT EQUAL FOLD(SHOULD BE SAME IGNORING CASE)", zap.Int("len_a", len(a)), zap.Int("len_b", len(b)), redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// HasPrefixFold checks if the string has the specified prefix ignoring case, panics if not.
+// HasPrefixFold 检查字符串在忽略大小写时是否有指定的前缀，没有则触发 panic。
+func HasPrefixFold(a string, prefix string, opts ...Redaction) {
+	if !utils.HasPrefixFold(a, prefix) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING PREFIX FOLD(SHOULD HAVE PREFIX IGNORING CASE)", zap.Int("len", len(a)), zap.Int("len_prefix", len(prefix)), redact("string", a, opts), redact("prefix", prefix, opts)) // only show redacted data in the log message
+	}
+}
+
+// ContainsFold checks if the string contains the specified substring ignoring case, panics if not.
+// ContainsFold 检查字符串在忽略大小写时是否包含指定的子串，没有则触发 panic。
+func ContainsFold(a string, sub string, opts ...Redaction) {
+	if !utils.ContainsFold(a, sub) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING SUBSTRING FOLD(SHOULD HAVE SUBSTRING IGNORING CASE)", zap.Int("len", len(a)), zap.Int("len_substring", len(sub)), redact("string", a, opts), redact("substring", sub, opts)) // only show redacted data in the log message
+	}
+}
+
+// OneOf checks if the string matches one of the candidates, panics if not. Returns the string when matched.
+// OneOf 检查字符串是否等于候选值之一，不是则触发 panic。匹配时返回该字符串。
+func OneOf(a string, candidates ...string) string {
+	for _, candidate := range candidates {
+		if a == candidate {
+			return a
+		}
+	}
+	zaplog.ZAPS.Skip1.LOG.Panic("STRING NOT ONE OF CANDIDATES(SHOULD BE ONE OF)", zap.Int("len", len(a)), zap.Int("candidates", len(candidates)), redact("string", a, nil), redactAll("candidate_values", candidates, nil)) // only show redacted data in the log message
+	return a
+}
+
+// NotBlank checks if the string is non-empty after trimming whitespace, panics if blank. Returns the string when not blank.
+// NotBlank 检查字符串去除空白后是否非空，为空白则触发 panic。非空白时返回该字符串。
+func NotBlank(a string) string {
+	if strings.TrimSpace(a) == "" {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING IS BLANK(SHOULD NOT BE BLANK)", zap.Int("len", len(a)))
+	}
+	return a
+}
+
+// ContainsAny checks if the string contains at least one of the substrings, panics if none found.
+// ContainsAny 检查字符串是否至少包含其中一个子串，都不包含则触发 panic。
+func ContainsAny(a string, subs ...string) {
+	for _, sub := range subs {
+		if strings.Contains(a, sub) {
+			return
+		}
+	}
+	zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING ALL SUBSTRINGS(SHOULD HAVE ANY SUBSTRING)", zap.Int("len", len(a)), zap.Int("candidates", len(subs)), redact("string", a, nil), redactAll("candidate_values", subs, nil)) // only show redacted data in the log message
+}
+
+// ContainsAll checks if the string contains each of the substrings, panics with the missing indexes if any is missing.
+// ContainsAll 检查字符串是否包含所有子串，有缺失则触发 panic 并记录缺失子串的索引。
+func ContainsAll(a string, subs ...string) {
+	if missing := utils.MissingSubstrings(a, subs); len(missing) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING SUBSTRINGS(SHOULD HAVE ALL SUBSTRINGS)", zap.Int("len", len(a)), zap.Ints("missing_indexes", missing), redact("string", a, nil), redactAll("missing_values", utils.Pick(subs, missing), nil)) // only show redacted data in the log message
+	}
+}
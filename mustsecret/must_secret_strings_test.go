@@ -0,0 +1,154 @@
+package mustsecret_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustsecret"
+)
+
+// TestHasPrefix tests secret string prefix assertions
+// Validates HasPrefix / NotHasPrefix pass and panic as the prefix presence requires
+//
+// TestHasPrefix 测试秘密字符串前缀断言
+// 验证 HasPrefix / NotHasPrefix 根据前缀是否存在通过或 panic
+func TestHasPrefix(t *testing.T) {
+	mustsecret.HasPrefix("sk-abc", "sk-")
+	mustsecret.NotHasPrefix("sk-abc", "pk-")
+
+	require.Panics(t, func() {
+		mustsecret.HasPrefix("sk-abc", "pk-")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.NotHasPrefix("sk-abc", "sk-")
+	})
+}
+
+// TestHasSuffix tests secret string suffix assertions
+// Validates HasSuffix / NotHasSuffix pass and panic as the suffix presence requires
+//
+// TestHasSuffix 测试秘密字符串后缀断言
+// 验证 HasSuffix / NotHasSuffix 根据后缀是否存在通过或 panic
+func TestHasSuffix(t *testing.T) {
+	mustsecret.HasSuffix("abc==", "==")
+	mustsecret.NotHasSuffix("abc==", "\n")
+
+	require.Panics(t, func() {
+		mustsecret.HasSuffix("abc", "==")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.NotHasSuffix("abc\n", "\n")
+	})
+}
+
+// TestHasSubstring tests secret string substring assertions
+// Validates HasSubstring / NotHasSubstring pass and panic as the substring presence requires
+//
+// TestHasSubstring 测试秘密字符串子串断言
+// 验证 HasSubstring / NotHasSubstring 根据子串是否存在通过或 panic
+func TestHasSubstring(t *testing.T) {
+	mustsecret.HasSubstring("user:pass@host", "@")
+	mustsecret.NotHasSubstring("token", " ")
+
+	require.Panics(t, func() {
+		mustsecret.HasSubstring("token", "@")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.NotHasSubstring("to ken", " ")
+	})
+}
+
+// TestEqualFold tests case-insensitive secret string equality assertion
+// Validates EqualFold passes when strings match ignoring case and panics when they differ
+//
+// TestEqualFold 测试忽略大小写的秘密字符串相等断言
+// 验证 EqualFold 在忽略大小写相等时通过，在不同时 panic
+func TestEqualFold(t *testing.T) {
+	mustsecret.EqualFold("ABCDEF", "abcdef")
+
+	require.Panics(t, func() {
+		mustsecret.EqualFold("abc", "abd")
+	})
+}
+
+// TestHasPrefixFold tests case-insensitive secret string prefix assertion
+// Validates HasPrefixFold passes when prefix matches ignoring case and panics when not found
+//
+// TestHasPrefixFold 测试忽略大小写的秘密字符串前缀断言
+// 验证 HasPrefixFold 在忽略大小写前缀匹配时通过，在前缀不存在时 panic
+func TestHasPrefixFold(t *testing.T) {
+	mustsecret.HasPrefixFold("Bearer abc", "bearer ")
+
+	require.Panics(t, func() {
+		mustsecret.HasPrefixFold("Basic abc", "bearer ")
+	})
+}
+
+// TestContainsFold tests case-insensitive secret string substring assertion
+// Validates ContainsFold passes when substring matches ignoring case and panics when not found
+//
+// TestContainsFold 测试忽略大小写的秘密字符串子串断言
+// 验证 ContainsFold 在忽略大小写包含子串时通过，在子串不存在时 panic
+func TestContainsFold(t *testing.T) {
+	mustsecret.ContainsFold("x-API-KEY-1", "api-key")
+	mustsecret.ContainsFold("abc", "")
+
+	require.Panics(t, func() {
+		mustsecret.ContainsFold("x-token", "api-key")
+	})
+}
+
+// TestOneOf tests secret string candidate membership assertion
+// Validates OneOf returns the string when matching a candidate and panics when not matching
+//
+// TestOneOf 测试秘密字符串候选值成员断言
+// 验证 OneOf 在匹配候选值时返回字符串，在不匹配时 panic
+func TestOneOf(t *testing.T) {
+	require.Equal(t, "b", mustsecret.OneOf("b", "a", "b"))
+
+	require.Panics(t, func() {
+		mustsecret.OneOf("c", "a", "b")
+	})
+}
+
+// TestNotBlank tests non-blank secret string assertion
+// Validates NotBlank returns non-blank strings and panics with whitespace-only strings
+//
+// TestNotBlank 测试非空白秘密字符串断言
+// 验证 NotBlank 返回非空白字符串，在仅含空白的字符串时 panic
+func TestNotBlank(t *testing.T) {
+	require.Equal(t, "abc", mustsecret.NotBlank("abc"))
+
+	require.Panics(t, func() {
+		mustsecret.NotBlank(" \t")
+	})
+}
+
+// TestContainsAny tests any-substring containment assertion on secret strings
+// Validates ContainsAny passes when one substring is found and panics when none found
+//
+// TestContainsAny 测试秘密字符串任一子串包含断言
+// 验证 ContainsAny 在找到任一子串时通过，在都未找到时 panic
+func TestContainsAny(t *testing.T) {
+	mustsecret.ContainsAny("abc", "x", "b")
+
+	require.Panics(t, func() {
+		mustsecret.ContainsAny("abc", "x", "y")
+	})
+}
+
+// TestContainsAll tests all-substrings containment assertion on secret strings
+// Validates ContainsAll passes when each substring is found and panics when any is missing
+//
+// TestContainsAll 测试秘密字符串所有子串包含断言
+// 验证 ContainsAll 在所有子串都找到时通过，在有缺失时 panic
+func TestContainsAll(t *testing.T) {
+	mustsecret.ContainsAll("abc", "a", "c")
+
+	require.Panics(t, func() {
+		mustsecret.ContainsAll("abc", "a", "x")
+	})
+}
@@ -0,0 +1,27 @@
+package mustsecret
+
+import (
+	"crypto/subtle"
+
+	"github.com/yyle88/zaplog"
+)
+
+// SameConstTime expects the strings to match, comparing in constant time to resist timing attacks. Panics if not matching.
+// Like crypto/subtle, the time depends on the lengths but not on the contents.
+// SameConstTime 期望字符串相等，使用常量时间比较以抵御计时攻击。如果不相等，则触发 panic。
+// 与 crypto/subtle 一样，耗时取决于长度而不取决于内容。
+func SameConstTime(a, b string, opts ...Redaction) {
+	if subtle.ConstantTimeCompare([]byte(a), []byte(b)) != 1 {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
+
+// SameBytes expects the byte slices (tokens, HMACs) to match, comparing in constant time to resist timing attacks. Panics if not matching.
+// Like crypto/subtle, the time depends on the lengths but not on the contents.
+// SameBytes 期望字节切片（令牌、HMAC）相等，使用常量时间比较以抵御计时攻击。如果不相等，则触发 panic。
+// 与 crypto/subtle 一样，耗时取决于长度而不取决于内容。
+func SameBytes(a, b []byte, opts ...Redaction) {
+	if subtle.ConstantTimeCompare(a, b) != 1 {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUES NOT SAME(SHOULD BE SAME)", redact("a", a, opts), redact("b", b, opts)) // only show redacted data in the log message
+	}
+}
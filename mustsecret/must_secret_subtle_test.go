@@ -0,0 +1,110 @@
+package mustsecret_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/internal/tests"
+	"github.com/yyle88/must/mustsecret"
+	"go.uber.org/zap"
+)
+
+// TestSameConstTime tests constant-time secret string equality assertion
+// Validates SameConstTime passes when strings match and panics when contents / lengths differ
+//
+// TestSameConstTime 测试常量时间的秘密字符串相等断言
+// 验证 SameConstTime 在字符串相同时通过，在内容/长度不同时 panic
+func TestSameConstTime(t *testing.T) {
+	mustsecret.SameConstTime("token-abc", "token-abc")
+	mustsecret.SameConstTime("", "")
+
+	require.Panics(t, func() {
+		mustsecret.SameConstTime("token-abc", "token-abd")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.SameConstTime("token-abc", "token-ab")
+	})
+}
+
+// TestSameBytes tests constant-time secret bytes equality assertion
+// Validates SameBytes passes when bytes match and panics when contents / lengths differ
+//
+// TestSameBytes 测试常量时间的秘密字节相等断言
+// 验证 SameBytes 在字节相同时通过，在内容/长度不同时 panic
+func TestSameBytes(t *testing.T) {
+	mustsecret.SameBytes([]byte{1, 2, 3}, []byte{1, 2, 3})
+	mustsecret.SameBytes(nil, []byte{})
+
+	require.Panics(t, func() {
+		mustsecret.SameBytes([]byte{1, 2, 3}, []byte{1, 2, 4})
+	})
+
+	require.Panics(t, func() {
+		mustsecret.SameBytes([]byte{1, 2, 3}, []byte{1, 2})
+	})
+}
+
+// BenchmarkSameConstTime measures the cost of constant-time string comparison
+// BenchmarkSameConstTime 测量常量时间字符串比较的开销
+func BenchmarkSameConstTime(b *testing.B) {
+	secret := string(bytes.Repeat([]byte{'x'}, 64))
+	other := string(bytes.Repeat([]byte{'x'}, 64))
+	for i := 0; i < b.N; i++ {
+		mustsecret.SameConstTime(secret, other)
+	}
+}
+
+// BenchmarkSame measures the cost of regular string comparison, as the reference of SameConstTime
+// BenchmarkSame 测量普通字符串比较的开销，作为 SameConstTime 的参照
+func BenchmarkSame(b *testing.B) {
+	secret := string(bytes.Repeat([]byte{'x'}, 64))
+	other := string(bytes.Repeat([]byte{'x'}, 64))
+	for i := 0; i < b.N; i++ {
+		mustsecret.Same(secret, other)
+	}
+}
+
+// BenchmarkSameBytes measures the cost of constant-time bytes comparison
+// BenchmarkSameBytes 测量常量时间字节比较的开销
+func BenchmarkSameBytes(b *testing.B) {
+	secret := bytes.Repeat([]byte{'x'}, 64)
+	other := bytes.Repeat([]byte{'x'}, 64)
+	for i := 0; i < b.N; i++ {
+		mustsecret.SameBytes(secret, other)
+	}
+}
+
+// BenchmarkSameBytes_DiffFirst measures the cost when the bytes differ at the first byte
+// Compare with BenchmarkSameBytes_DiffLast, a constant-time compare costs about the same in both
+//
+// BenchmarkSameBytes_DiffFirst 测量字节在首字节不同时的开销
+// 与 BenchmarkSameBytes_DiffLast 对比，常量时间比较在两种情况下的开销大致相同
+func BenchmarkSameBytes_DiffFirst(b *testing.B) {
+	secret := bytes.Repeat([]byte{'x'}, 4096)
+	other := bytes.Clone(secret)
+	other[0] = 'y'
+	benchmarkMismatch(b, secret, other)
+}
+
+// BenchmarkSameBytes_DiffLast measures the cost when the bytes differ at the last byte
+// BenchmarkSameBytes_DiffLast 测量字节在末字节不同时的开销
+func BenchmarkSameBytes_DiffLast(b *testing.B) {
+	secret := bytes.Repeat([]byte{'x'}, 4096)
+	other := bytes.Clone(secret)
+	other[len(other)-1] = 'y'
+	benchmarkMismatch(b, secret, other)
+}
+
+// benchmarkMismatch runs SameBytes on mismatching bytes, recovering the panic of each round
+// benchmarkMismatch 对不相同的字节运行 SameBytes，并恢复每轮的 panic
+func benchmarkMismatch(b *testing.B, secret, other []byte) {
+	tests.ReplaceLog(b, zap.NewNop()) // keep the failure logs out of the benchmark output
+	for i := 0; i < b.N; i++ {
+		func() {
+			defer func() { _ = recover() }()
+			mustsecret.SameBytes(secret, other)
+		}()
+	}
+}
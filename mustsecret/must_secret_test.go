@@ -8,10 +8,16 @@
 package mustsecret_test
 
 import (
+	"io"
+	"os"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/internal/tests"
 	"github.com/yyle88/must/mustsecret"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 // TestNice tests non-zero secret value assertion
@@ -82,3 +88,317 @@ func TestSane(t *testing.T) {
 		mustsecret.Sane(0, 0)
 	})
 }
+
+// requireRedacted runs fn expecting a panic and checks the secret does not appear in the logged message / fields
+//
+// requireRedacted 运行 fn 并期望 panic，检查机密不会出现在记录的消息/字段中
+func requireRedacted(t *testing.T, secret string, fn func()) {
+	logs := tests.ObserveLogs(t)
+
+	require.Panics(t, fn)
+	require.Equal(t, 1, logs.Len())
+	encoder := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	for _, entry := range logs.All() {
+		output, err := encoder.EncodeEntry(entry.Entry, entry.Context)
+		require.NoError(t, err)
+		require.NotContains(t, output.String(), secret)
+	}
+}
+
+// TestRedacted tests that failures of value-comparing assertions keep secrets out of the log
+// Validates the secret value is absent from both message and fields
+//
+// TestRedacted 测试值比较类断言失败时机密不会进入日志
+// 验证机密值不出现在消息和字段中
+func TestRedacted(t *testing.T) {
+	const secret = "sk-live-0123456789"
+
+	requireRedacted(t, secret, func() { mustsecret.Same(secret, "other") })
+	requireRedacted(t, secret, func() { mustsecret.Equals(secret, "other") })
+	requireRedacted(t, secret, func() { mustsecret.Diff(secret, secret) })
+	requireRedacted(t, secret, func() { mustsecret.Zero(secret) })
+	requireRedacted(t, secret, func() { mustsecret.Done(errors.New("bad token " + secret)) })
+	requireRedacted(t, secret, func() { mustsecret.Ise(errors.New(secret), errors.New("x")) })
+	requireRedacted(t, secret, func() { mustsecret.In(secret, []string{"a"}) })
+	requireRedacted(t, secret, func() { mustsecret.HasPrefix(secret, "pk-") })
+	requireRedacted(t, secret, func() { mustsecret.OneOf(secret, "a", "b") })
+	requireRedacted(t, secret, func() { mustsecret.Unique([]string{secret, secret}) })
+	requireRedacted(t, secret, func() { mustsecret.Subset([]string{secret}, []string{"a"}) })
+	requireRedacted(t, secret, func() { mustsecret.Get(map[string]int{}, secret) })
+	requireRedacted(t, secret, func() { mustsecret.HasKeys(map[string]int{}, secret) })
+}
+
+// TestTrue tests boolean true assertion
+// Validates True / TRUE pass with true and panic with false
+//
+// TestTrue 测试布尔 true 断言
+// 验证 True / TRUE 在 true 时通过，在 false 时 panic
+func TestTrue(t *testing.T) {
+	mustsecret.True(true)
+	mustsecret.TRUE(true)
+
+	require.Panics(t, func() {
+		mustsecret.True(false)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.TRUE(false)
+	})
+}
+
+// TestFalse tests boolean false assertion
+// Validates False / FALSE pass with false and panic with true
+//
+// TestFalse 测试布尔 false 断言
+// 验证 False / FALSE 在 false 时通过，在 true 时 panic
+func TestFalse(t *testing.T) {
+	mustsecret.False(false)
+	mustsecret.FALSE(false)
+
+	require.Panics(t, func() {
+		mustsecret.False(true)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.FALSE(true)
+	})
+}
+
+// TestDone tests no error assertion without logging the error message
+// Validates Done / Must pass with nil error and panic with non-nil error
+//
+// TestDone 测试无错误断言，不记录错误信息
+// 验证 Done / Must 在无错误时通过，在有错误时 panic
+func TestDone(t *testing.T) {
+	mustsecret.Done(nil)
+	mustsecret.Must(nil)
+
+	require.Panics(t, func() {
+		mustsecret.Done(errors.New("wa"))
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Must(errors.New("wa"))
+	})
+}
+
+// TestNone tests zero secret value assertion (alias of Zero)
+// Validates None passes with zero values and panics with non-zero values
+//
+// TestNone 测试零秘密值断言（Zero 的别名）
+// 验证 None 在零值时通过，在非零值时 panic
+func TestNone(t *testing.T) {
+	mustsecret.None("")
+
+	require.Panics(t, func() {
+		mustsecret.None("some-value")
+	})
+}
+
+// TestNull tests nil pointer assertion
+// Validates Null passes with nil and panics with non-nil pointers
+//
+// TestNull 测试 nil 指针断言
+// 验证 Null 在 nil 时通过，在非 nil 指针时 panic
+func TestNull(t *testing.T) {
+	mustsecret.Null[string](nil)
+
+	require.Panics(t, func() {
+		mustsecret.Null(new(string))
+	})
+}
+
+// TestFull tests non-nil pointer assertion with return
+// Validates Full returns non-nil pointers and panics with nil
+//
+// TestFull 测试非 nil 指针断言并返回
+// 验证 Full 返回非 nil 指针，在 nil 时 panic
+func TestFull(t *testing.T) {
+	p := new(string)
+	require.Equal(t, p, mustsecret.Full(p))
+
+	require.Panics(t, func() {
+		mustsecret.Full[string](nil)
+	})
+}
+
+// TestEquals tests secret value equality assertion
+// Validates Equals / Is pass when values match and panic when values differ
+//
+// TestEquals 测试秘密值相等断言
+// 验证 Equals / Is 在值匹配时通过，在值不同时 panic
+func TestEquals(t *testing.T) {
+	mustsecret.Equals("abc", "abc")
+	mustsecret.Is(1, 1)
+
+	require.Panics(t, func() {
+		mustsecret.Equals("abc", "xyz")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Is(1, 2)
+	})
+}
+
+// TestSameNice tests secret value sameness with non-zero check and return
+// Validates SameNice returns matching non-zero values and panics on mismatch / zero
+//
+// TestSameNice 测试秘密值相同且非零断言并返回
+// 验证 SameNice 返回匹配的非零值，在不匹配/为零时 panic
+func TestSameNice(t *testing.T) {
+	require.Equal(t, "abc", mustsecret.SameNice("abc", "abc"))
+
+	require.Panics(t, func() {
+		mustsecret.SameNice("abc", "xyz")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.SameNice("", "")
+	})
+}
+
+// TestDiff tests secret value difference assertion
+// Validates Diff / Different pass when values differ and panic when values match
+//
+// TestDiff 测试秘密值差异断言
+// 验证 Diff / Different 在值不同时通过，在值相同时 panic
+func TestDiff(t *testing.T) {
+	mustsecret.Diff("abc", "xyz")
+	mustsecret.Different("abc", "xyz")
+
+	require.Panics(t, func() {
+		mustsecret.Diff("abc", "abc")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Different("abc", "abc")
+	})
+}
+
+// TestIse tests error matching assertion without logging the error messages
+// Validates Ise passes when errors.Is matches and panics when not matching
+//
+// TestIse 测试错误匹配断言，不记录错误信息
+// 验证 Ise 在 errors.Is 匹配时通过，在不匹配时 panic
+func TestIse(t *testing.T) {
+	target := errors.New("target")
+	mustsecret.Ise(errors.Wrap(target, "wrap"), target)
+
+	require.Panics(t, func() {
+		mustsecret.Ise(errors.New("other"), target)
+	})
+}
+
+// TestOk tests non-zero secret value assertion
+// Validates Ok / OK pass with non-zero values and panic with zero values
+//
+// TestOk 测试非零秘密值断言
+// 验证 Ok / OK 在非零值时通过，在零值时 panic
+func TestOk(t *testing.T) {
+	mustsecret.Ok("abc")
+	mustsecret.OK(1)
+
+	require.Panics(t, func() {
+		mustsecret.Ok("")
+	})
+
+	require.Panics(t, func() {
+		mustsecret.OK(0)
+	})
+}
+
+// TestCause tests error presence assertion
+// Validates Cause / Wrong pass with non-nil error and panic with nil error
+//
+// TestCause 测试错误存在断言
+// 验证 Cause / Wrong 在有错误时通过，在无错误时 panic
+func TestCause(t *testing.T) {
+	err := errors.New("wa")
+	require.Equal(t, err, mustsecret.Cause(err))
+	mustsecret.Wrong(err)
+
+	require.Panics(t, func() {
+		mustsecret.Cause(nil)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Wrong(nil)
+	})
+}
+
+// TestTypedNil tests typed-nil detection in non-zero, non-nil and error presence assertions
+// Validates Nice, Full, Cause and Wrong panic when an interface wraps a nil pointer
+//
+// TestTypedNil 测试非零、非 nil 和错误存在断言中的类型化 nil 检测
+// 验证 Nice、Full、Cause 和 Wrong 在接口包装了 nil 指针时 panic
+func TestTypedNil(t *testing.T) {
+	var file *os.File
+	var reader io.Reader = file
+	var pathErr *os.PathError
+	var err error = pathErr
+
+	require.Panics(t, func() {
+		mustsecret.Nice(reader)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Full(&reader)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Cause(err)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Wrong(err)
+	})
+}
+
+// TestHave tests slice non-empty assertion
+// Validates Have returns non-empty slices and panics with empty slices
+//
+// TestHave 测试切片非空断言
+// 验证 Have 返回非空切片，在空切片时 panic
+func TestHave(t *testing.T) {
+	require.Equal(t, []string{"a"}, mustsecret.Have([]string{"a"}))
+
+	require.Panics(t, func() {
+		mustsecret.Have([]string{})
+	})
+}
+
+// TestLength tests slice length assertion
+// Validates Length / Len pass when slice length matches and panic on mismatch
+//
+// TestLength 测试切片长度断言
+// 验证 Length / Len 在切片长度匹配时通过，在不匹配时 panic
+func TestLength(t *testing.T) {
+	mustsecret.Length([]string{"a", "b"}, 2)
+	mustsecret.Len([]string{"a", "b"}, 2)
+
+	require.Panics(t, func() {
+		mustsecret.Length([]string{"a"}, 2)
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Len([]string{"a"}, 2)
+	})
+}
+
+// TestIn tests secret membership in slice assertion
+// Validates In / Contains pass when the value is in the slice and panic when not found
+//
+// TestIn 测试秘密值在切片中的成员断言
+// 验证 In / Contains 在值存在于切片中时通过，在未找到时 panic
+func TestIn(t *testing.T) {
+	mustsecret.In("b", []string{"a", "b"})
+	mustsecret.Contains([]string{"a", "b"}, "b")
+
+	require.Panics(t, func() {
+		mustsecret.In("c", []string{"a", "b"})
+	})
+
+	require.Panics(t, func() {
+		mustsecret.Contains([]string{"a", "b"}, "c")
+	})
+}
@@ -0,0 +1,72 @@
+package mustsecret
+
+import (
+	"fmt"
+
+	"github.com/yyle88/must/internal/utils"
+	"go.uber.org/zap/zapcore"
+)
+
+// concealed is the text printed / logged in place of the secret content
+// concealed 是代替机密内容打印/记录的文本
+const concealed = "[REDACTED]"
+
+// Secret wraps a sensitive value so that fmt, encoding/json, zap and the must packages never print the content
+// Use Reveal to get the content when it is needed, such as sending it in a request
+//
+// Secret 包装敏感值，使 fmt、encoding/json、zap 以及 must 系列包都不会打印其内容
+// 需要内容时（例如在请求中发送）使用 Reveal 获取
+type Secret[T any] struct {
+	value T
+}
+
+// NewSecret wraps the value into a Secret.
+// NewSecret 将值包装为 Secret。
+func NewSecret[T any](value T) Secret[T] {
+	return Secret[T]{value: value}
+}
+
+// Reveal returns the wrapped content.
+// Reveal 返回被包装的内容。
+func (s Secret[T]) Reveal() T {
+	return s.value
+}
+
+// String returns the redacted text, implementing fmt.Stringer.
+// String 返回脱敏文本，实现 fmt.Stringer。
+func (s Secret[T]) String() string {
+	return concealed
+}
+
+// GoString returns the redacted text, implementing fmt.GoStringer.
+// GoString 返回脱敏文本，实现 fmt.GoStringer。
+func (s Secret[T]) GoString() string {
+	return concealed
+}
+
+// Format writes the redacted text with each verb, so even %d or %x never print the content.
+// Format 对任何格式动词都写出脱敏文本，即使 %d 或 %x 也不会打印内容。
+func (s Secret[T]) Format(f fmt.State, verb rune) {
+	_, _ = f.Write([]byte(concealed))
+}
+
+// MarshalJSON returns the redacted text as a JSON string, implementing json.Marshaler.
+// MarshalJSON 以 JSON 字符串返回脱敏文本，实现 json.Marshaler。
+func (s Secret[T]) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + concealed + `"`), nil
+}
+
+// MarshalLogObject writes the redacted text, implementing zapcore.ObjectMarshaler.
+// MarshalLogObject 写出脱敏文本，实现 zapcore.ObjectMarshaler。
+func (s Secret[T]) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("secret", concealed)
+	return nil
+}
+
+// Conceal returns the redacted text, letting the must packages recognize the secret and refuse to print the content.
+// Conceal 返回脱敏文本，让 must 系列包识别该机密并拒绝打印内容。
+func (s Secret[T]) Conceal() string {
+	return concealed
+}
+
+var _ utils.Concealer = Secret[string]{}
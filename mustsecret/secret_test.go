@@ -0,0 +1,92 @@
+package mustsecret_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must"
+	"github.com/yyle88/must/mustmap"
+	"github.com/yyle88/must/mustsecret"
+	"github.com/yyle88/must/mustslice"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// TestSecret_Reveal tests wrapping and revealing the secret content
+// Validates Reveal returns the wrapped content
+//
+// TestSecret_Reveal 测试包装并取出机密内容
+// 验证 Reveal 返回被包装的内容
+func TestSecret_Reveal(t *testing.T) {
+	require.Equal(t, "sk-abc", mustsecret.NewSecret("sk-abc").Reveal())
+	require.Equal(t, []byte{1, 2}, mustsecret.NewSecret([]byte{1, 2}).Reveal())
+}
+
+// TestSecret_Format tests printing the secret with fmt
+// Validates each verb prints the redacted text, also when nested in structs
+//
+// TestSecret_Format 测试使用 fmt 打印机密
+// 验证每个格式动词都打印脱敏文本，嵌套在结构体中时也是如此
+func TestSecret_Format(t *testing.T) {
+	secret := mustsecret.NewSecret("sk-abc")
+	require.Equal(t, "[REDACTED]", secret.String())
+	require.Equal(t, "[REDACTED]", secret.GoString())
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x", "%d"} {
+		require.Equal(t, "[REDACTED]", fmt.Sprintf(format, secret), format)
+	}
+
+	type config struct {
+		Host  string
+		Token mustsecret.Secret[string]
+	}
+	require.NotContains(t, fmt.Sprintf("%+v", config{"h", secret}), "sk-abc")
+	require.NotContains(t, fmt.Sprintf("%#v", config{"h", secret}), "sk-abc")
+}
+
+// TestSecret_MarshalJSON tests encoding the secret with encoding/json
+// Validates the redacted text is encoded, also when nested in structs
+//
+// TestSecret_MarshalJSON 测试使用 encoding/json 编码机密
+// 验证编码脱敏文本，嵌套在结构体中时也是如此
+func TestSecret_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(map[string]any{"token": mustsecret.NewSecret("sk-abc")})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"token":"[REDACTED]"}`, string(data))
+}
+
+// TestSecret_MarshalLogObject tests logging the secret with zap
+// Validates the zap object encoding holds the redacted text
+//
+// TestSecret_MarshalLogObject 测试使用 zap 记录机密
+// 验证 zap 对象编码中只有脱敏文本
+func TestSecret_MarshalLogObject(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+	require.NoError(t, mustsecret.NewSecret("sk-abc").MarshalLogObject(enc))
+	require.Equal(t, map[string]any{"secret": "[REDACTED]"}, enc.Fields)
+
+	require.Equal(t, zapcore.ObjectMarshalerType, zap.Any("token", mustsecret.NewSecret("sk-abc")).Type)
+}
+
+// TestSecret_MustPackages tests the must packages refusing to print the secret content
+// Validates failures in must, mustslice and mustmap keep the content out of the log
+//
+// TestSecret_MustPackages 测试 must 系列包拒绝打印机密内容
+// 验证 must、mustslice 和 mustmap 中的失败不会把内容写入日志
+func TestSecret_MustPackages(t *testing.T) {
+	const content = "sk-live-0123456789"
+	secret := mustsecret.NewSecret(content)
+	other := mustsecret.NewSecret("sk-live-other")
+
+	requireRedacted(t, content, func() { must.Same(secret, other) })
+	requireRedacted(t, content, func() { must.Zero(secret) })
+	requireRedacted(t, content, func() { must.In(secret, []mustsecret.Secret[string]{other}) })
+	requireRedacted(t, content, func() { must.SameNice(secret, other) })
+	requireRedacted(t, content, func() { mustslice.Unique([]mustsecret.Secret[string]{secret, secret}) })
+	requireRedacted(t, content, func() {
+		mustslice.All([]mustsecret.Secret[string]{secret}, func(mustsecret.Secret[string]) bool { return false })
+	})
+	requireRedacted(t, content, func() { mustmap.Get(map[mustsecret.Secret[string]]int{}, secret) })
+	requireRedacted(t, content, func() { must.Diff(secret, secret) })
+}
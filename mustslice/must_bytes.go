@@ -0,0 +1,81 @@
+package mustslice
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// hexDumpLimit is the count of bytes shown in each hex dump on failure
+// hexDumpLimit 是失败时每个十六进制转储中显示的字节数
+const hexDumpLimit = 128
+
+// BytesEqual checks if two byte slices match, panics with hex dumps around the first differing offset if not.
+// BytesEqual 检查两个字节切片是否相等，不相等则触发 panic 并记录第一个不同偏移处附近的十六进制转储。
+func BytesEqual(a, b []byte) {
+	if !bytes.Equal(a, b) {
+		offset := 0
+		for offset < len(a) && offset < len(b) && a[offset] == b[offset] {
+			offset++
+		}
+		zaplog.ZAPS.Skip1.LOG.Panic("BYTES NOT SAME(SHOULD BE SAME)",
+			zap.Int("offset", offset),
+			zap.Int("len_a", len(a)),
+			zap.Int("len_b", len(b)),
+			zap.String("hex_a", hexDump(a, offset)),
+			zap.String("hex_b", hexDump(b, offset)),
+		)
+	}
+}
+
+// BytesHasPrefix checks if the byte slice has the specified prefix, panics with hex dumps if not.
+// BytesHasPrefix 检查字节切片是否有指定的前缀，没有则触发 panic 并记录十六进制转储。
+func BytesHasPrefix(a, prefix []byte) {
+	if !bytes.HasPrefix(a, prefix) {
+		offset := 0
+		for offset < len(a) && offset < len(prefix) && a[offset] == prefix[offset] {
+			offset++
+		}
+		zaplog.ZAPS.Skip1.LOG.Panic("BYTES MISSING PREFIX(SHOULD HAVE PREFIX)",
+			zap.Int("offset", offset),
+			zap.Int("len", len(a)),
+			zap.Int("len_prefix", len(prefix)),
+			zap.String("hex", hexDump(a, offset)),
+			zap.String("hex_prefix", hexDump(prefix, offset)),
+		)
+	}
+}
+
+// hexDump renders up to hexDumpLimit bytes in rows of 16 starting a row before the row holding offset
+// Each row shows the absolute offset, the hex bytes and the printable ASCII characters
+//
+// hexDump 从包含 offset 的行的前一行开始，以每行 16 字节渲染最多 hexDumpLimit 字节
+// 每行显示绝对偏移量、十六进制字节和可打印的 ASCII 字符
+func hexDump(data []byte, offset int) string {
+	start := max(0, offset/16*16-16)
+	if start > len(data) {
+		start = len(data) / 16 * 16
+	}
+	end := min(len(data), start+hexDumpLimit)
+
+	var sb strings.Builder
+	for row := start; row < end; row += 16 {
+		line := data[row:min(row+16, end)]
+		sb.WriteString(fmt.Sprintf("%08x  % -47x  |", row, line))
+		for _, c := range line {
+			if c >= 0x20 && c <= 0x7e {
+				sb.WriteByte(c)
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteString("|\n")
+	}
+	if end < len(data) {
+		sb.WriteString(fmt.Sprintf("... (%d more bytes)\n", len(data)-end))
+	}
+	return sb.String()
+}
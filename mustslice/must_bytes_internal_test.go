@@ -0,0 +1,23 @@
+package mustslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestHexDump tests hex dump rendering around the offset
+// Validates rows show absolute offsets and the dump starts a row before the offset
+//
+// TestHexDump 测试偏移处附近的十六进制转储渲染
+// 验证每行显示绝对偏移量，且转储从偏移所在行的前一行开始
+func TestHexDump(t *testing.T) {
+	require.Equal(t, "00000000  68 69                                            |hi|\n", hexDump([]byte("hi"), 1))
+	require.Equal(t, "", hexDump(nil, 0))
+
+	data := make([]byte, 300)
+	res := hexDump(data, 40)
+	require.Contains(t, res, "00000010  00 00")
+	require.NotContains(t, res, "00000000  ")
+	require.Contains(t, res, "... (156 more bytes)\n")
+}
@@ -0,0 +1,39 @@
+package mustslice_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustslice"
+)
+
+// TestBytesEqual tests byte slice equality assertion
+// Validates BytesEqual passes when bytes match and panics when bytes differ
+//
+// TestBytesEqual 测试字节切片相等断言
+// 验证 BytesEqual 在字节相同时通过，在字节不同时 panic
+func TestBytesEqual(t *testing.T) {
+	mustslice.BytesEqual([]byte("abc"), []byte("abc"))
+	mustslice.BytesEqual(nil, []byte{})
+
+	require.Panics(t, func() {
+		mustslice.BytesEqual([]byte("abc"), []byte("abd"))
+	})
+
+	require.Panics(t, func() {
+		mustslice.BytesEqual([]byte("abc"), []byte("ab"))
+	})
+}
+
+// TestBytesHasPrefix tests byte slice prefix assertion
+// Validates BytesHasPrefix passes when the prefix matches and panics when not
+//
+// TestBytesHasPrefix 测试字节切片前缀断言
+// 验证 BytesHasPrefix 在前缀匹配时通过，否则 panic
+func TestBytesHasPrefix(t *testing.T) {
+	mustslice.BytesHasPrefix([]byte{0x89, 'P', 'N', 'G', 0x0d}, []byte{0x89, 'P', 'N', 'G'})
+
+	require.Panics(t, func() {
+		mustslice.BytesHasPrefix([]byte("GIF89a"), []byte{0x89, 'P', 'N', 'G'})
+	})
+}
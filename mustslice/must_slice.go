@@ -1,19 +1,22 @@
 // Package mustslice provides slice-specific assertion utilities with panic-on-failure semantics
 // Implements type-safe slice validation functions using Go generics and standard slices package
-// Supports comparison operations, membership testing, and length validation on slice types
+// Supports comparison operations, membership testing, and length validation on slice types, plus byte slices with hex dumps
 // Integrates with zap structured logging to provide detailed context when assertions are not met
 //
 // mustslice 提供切片特定的断言工具，带 panic-on-failure 语义
 // 使用 Go 泛型和标准 slices 包实现类型安全的切片验证函数
-// 支持切片类型的比较操作、成员测试和长度验证
+// 支持切片类型的比较操作、成员测试和长度验证，以及带十六进制转储的字节切片断言
 // 与 zap 结构化日志集成，当断言不满足时提供详细上下文
 package mustslice
 
 import (
+	"cmp"
 	"slices"
 
+	"github.com/yyle88/must/internal/utils"
 	"github.com/yyle88/zaplog"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 // Equals checks if two slices match, panics if not.
@@ -44,7 +47,7 @@ func Different[V comparable](a, b []V) {
 // In 检查某个元素是否存在于切片中，不存在则触发 panic。
 func In[T comparable](v T, a []T) {
 	if !slices.Contains(a, v) {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Any("v", v), zap.Int("len", len(a)))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", utils.Any("v", v), zap.Int("len", len(a)))
 	}
 }
 
@@ -52,7 +55,7 @@ func In[T comparable](v T, a []T) {
 // Contains 检查切片是否包含某个特定元素，不包含则触发 panic。
 func Contains[T comparable](a []T, v T) {
 	if !slices.Contains(a, v) {
-		zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Int("len", len(a)), zap.Any("v", v))
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Int("len", len(a)), utils.Any("v", v))
 	}
 }
 
@@ -105,3 +108,234 @@ func Len[T any](a []T, n int) {
 		zaplog.ZAPS.Skip1.LOG.Panic("LENGTH MISMATCH(NOT MATCH)", zap.Int("len", len(a)), zap.Int("n", n))
 	}
 }
+
+// All checks if each element satisfies the predicate, panics with the first offending element if not.
+// All 检查每个元素是否都满足断言函数，不满足则触发 panic 并记录第一个不满足的元素。
+func All[T any](a []T, pred func(T) bool) {
+	for idx, v := range a {
+		if !pred(v) {
+			zaplog.ZAPS.Skip1.LOG.Panic("ELEMENT NOT MATCH(SHOULD ALL MATCH)", zap.Int("index", idx), utils.Any("v", v), zap.Int("len", len(a)))
+		}
+	}
+}
+
+// Any checks if at least one element satisfies the predicate, panics if none.
+// Any 检查是否至少有一个元素满足断言函数，都不满足则触发 panic。
+func Any[T any](a []T, pred func(T) bool) {
+	for _, v := range a {
+		if pred(v) {
+			return
+		}
+	}
+	zaplog.ZAPS.Skip1.LOG.Panic("NO ELEMENT MATCH(SHOULD ANY MATCH)", zap.Int("len", len(a)))
+}
+
+// NoneMatch checks if no element satisfies the predicate, panics with the first matching element if any.
+// NoneMatch 检查是否没有元素满足断言函数，有则触发 panic 并记录第一个满足的元素。
+func NoneMatch[T any](a []T, pred func(T) bool) {
+	for idx, v := range a {
+		if pred(v) {
+			zaplog.ZAPS.Skip1.LOG.Panic("ELEMENT MATCH(SHOULD NONE MATCH)", zap.Int("index", idx), utils.Any("v", v), zap.Int("len", len(a)))
+		}
+	}
+}
+
+// CountEq checks if the count of elements satisfying the predicate is n, panics if not.
+// CountEq 检查满足断言函数的元素数量是否为 n，不是则触发 panic。
+func CountEq[T any](a []T, pred func(T) bool, n int) {
+	var indexes []int
+	for idx, v := range a {
+		if pred(v) {
+			indexes = append(indexes, idx)
+		}
+	}
+	if len(indexes) != n {
+		zaplog.ZAPS.Skip1.LOG.Panic("COUNT MISMATCH(NOT MATCH)", zap.Int("count", len(indexes)), zap.Int("n", n), zap.Ints("indexes", indexes), zap.Int("len", len(a)))
+	}
+}
+
+// Find returns the first element satisfying the predicate, panics if nothing matches.
+// Find 返回第一个满足断言函数的元素，没有匹配则触发 panic。
+func Find[T any](a []T, pred func(T) bool) T {
+	for _, v := range a {
+		if pred(v) {
+			return v
+		}
+	}
+	zaplog.ZAPS.Skip1.LOG.Panic("ELEMENT NOT FOUND(SHOULD BE FOUND)", zap.Int("len", len(a)))
+	return utils.Zero[T]()
+}
+
+// Unique checks if the slice has no duplicate elements, panics with each duplicate and its count if any.
+// Unique 检查切片是否没有重复元素，有重复则触发 panic 并记录每个重复元素及其出现次数。
+func Unique[T comparable](a []T) []T {
+	if indexes := utils.DuplicateIndexes(a); len(indexes) > 0 {
+		counts := utils.Counts(a)
+		duplicates := make(duplicateCounts[T], 0, len(indexes))
+		for _, idx := range indexes {
+			duplicates = append(duplicates, duplicateCount[T]{value: a[idx], count: counts[a[idx]]})
+		}
+		zaplog.ZAPS.Skip1.LOG.Panic("HAS DUPLICATES(SHOULD BE UNIQUE)", zap.Array("duplicates", duplicates), zap.Int("len", len(a)))
+	}
+	return a
+}
+
+// duplicateCount is a duplicate element with its count of occurrences, logged by Unique
+// duplicateCount 是重复元素及其出现次数，由 Unique 记录
+type duplicateCount[T comparable] struct {
+	value T
+	count int
+}
+
+// duplicateCounts lists each duplicate element once, in order of first repetition
+// duplicateCounts 按首次重复的顺序列出每个重复元素一次
+type duplicateCounts[T comparable] []duplicateCount[T]
+
+// MarshalLogArray implements zapcore.ArrayMarshaler
+// MarshalLogArray 实现 zapcore.ArrayMarshaler
+func (duplicates duplicateCounts[T]) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, duplicate := range duplicates {
+		if err := enc.AppendObject(duplicate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler
+// MarshalLogObject 实现 zapcore.ObjectMarshaler
+func (duplicate duplicateCount[T]) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	utils.Any("value", duplicate.value).AddTo(enc)
+	enc.AddInt("count", duplicate.count)
+	return nil
+}
+
+// ElementsMatch checks if two slices contain the same elements with the same counts ignoring order, panics if not.
+// ElementsMatch 检查两个切片在忽略顺序时是否包含相同元素且数量一致，不一致则触发 panic。
+func ElementsMatch[T comparable](a, b []T) {
+	missing, extra := utils.ElementsDiff(a, b) // missing: in a but not in b, extra: in b but not in a
+	if len(missing) > 0 || len(extra) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("ELEMENTS NOT MATCH(SHOULD MATCH)", utils.Any("missing", utils.Pick(a, missing)), utils.Any("extra", utils.Pick(b, extra)), zap.Int("len_a", len(a)), zap.Int("len_b", len(b)))
+	}
+}
+
+// Subset checks if each element of sub is in a, panics with the missing elements if not.
+// Subset 检查 sub 的每个元素是否都在 a 中，不在则触发 panic 并记录缺失的元素。
+func Subset[T comparable](sub, a []T) {
+	if missing := utils.DifferenceIndexes(sub, a); len(missing) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SUBSET(SHOULD BE SUBSET)", utils.Any("missing", utils.Pick(sub, missing)), zap.Int("len_sub", len(sub)), zap.Int("len", len(a)))
+	}
+}
+
+// Superset checks if a contains each element of sub, panics with the missing elements if not.
+// Superset 检查 a 是否包含 sub 的每个元素，不包含则触发 panic 并记录缺失的元素。
+func Superset[T comparable](a, sub []T) {
+	if missing := utils.DifferenceIndexes(sub, a); len(missing) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SUPERSET(SHOULD BE SUPERSET)", utils.Any("missing", utils.Pick(sub, missing)), zap.Int("len", len(a)), zap.Int("len_sub", len(sub)))
+	}
+}
+
+// Disjoint checks if two slices share no elements, panics with the common elements if any.
+// Disjoint 检查两个切片是否没有共同元素，有则触发 panic 并记录共同的元素。
+func Disjoint[T comparable](a, b []T) {
+	if common := utils.CommonIndexes(a, b); len(common) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("HAS COMMON ELEMENTS(SHOULD BE DISJOINT)", utils.Any("common", utils.Pick(a, common)), zap.Int("len_a", len(a)), zap.Int("len_b", len(b)))
+	}
+}
+
+// Index returns the element at index i, panics if i is out of range.
+// Index 返回索引 i 处的元素，如果 i 越界则触发 panic。
+func Index[T any](a []T, i int) T {
+	if i < 0 || i >= len(a) {
+		zaplog.ZAPS.Skip1.LOG.Panic("INDEX OUT OF RANGE(SHOULD BE IN RANGE)", zap.Int("index", i), zap.Int("len", len(a)))
+		return utils.Zero[T]()
+	}
+	return a[i]
+}
+
+// First returns the first element, panics if the slice is vacant.
+// First 返回第一个元素，如果切片为空则触发 panic。
+func First[T any](a []T) T {
+	if len(a) == 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("SLICE IS EMPTY(SHOULD HAVE FIRST)", zap.Int("index", 0), zap.Int("len", len(a)))
+		return utils.Zero[T]()
+	}
+	return a[0]
+}
+
+// Last returns the last element, panics if the slice is vacant.
+// Last 返回最后一个元素，如果切片为空则触发 panic。
+func Last[T any](a []T) T {
+	if len(a) == 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("SLICE IS EMPTY(SHOULD HAVE LAST)", zap.Int("index", -1), zap.Int("len", len(a)))
+		return utils.Zero[T]()
+	}
+	return a[len(a)-1]
+}
+
+// Only returns the sole element, panics if the slice does not have exactly one element.
+// Only 返回唯一的元素，如果切片不是恰好一个元素则触发 panic。
+func Only[T any](a []T) T {
+	if len(a) != 1 {
+		zaplog.ZAPS.Skip1.LOG.Panic("LENGTH MISMATCH(SHOULD HAVE ONLY ONE)", zap.Int("len", len(a)), zap.Int("n", 1))
+		return utils.Zero[T]()
+	}
+	return a[0]
+}
+
+// SortedFunc checks if the slice is sorted in ascending order by the compare function, panics with the first out-of-order pair if not.
+// SortedFunc 检查切片是否按 compare 函数升序排列，不是则触发 panic 并记录第一对乱序的元素。
+func SortedFunc[T any](a []T, compare func(x, y T) int) {
+	if idx := utils.UnsortedIndex(a, compare); idx >= 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SORTED(SHOULD BE SORTED)", zap.Int("index", idx), utils.Any("prev", a[idx-1]), utils.Any("next", a[idx]), zap.Int("len", len(a)))
+	}
+}
+
+// IsSortedBy checks if the slice is sorted in ascending order by the key, panics with the first out-of-order pair if not.
+// IsSortedBy 检查切片是否按 key 升序排列，不是则触发 panic 并记录第一对乱序的元素。
+func IsSortedBy[T any, K cmp.Ordered](a []T, keyFn func(T) K) {
+	if idx := utils.UnsortedIndex(a, func(x, y T) int { return cmp.Compare(keyFn(x), keyFn(y)) }); idx >= 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SORTED BY KEY(SHOULD BE SORTED)", zap.Int("index", idx), utils.Any("prev_key", keyFn(a[idx-1])), utils.Any("next_key", keyFn(a[idx])), utils.Any("prev", a[idx-1]), utils.Any("next", a[idx]), zap.Int("len", len(a)))
+	}
+}
+
+// BinarySearch returns the index of v in the sorted slice, panics if v is not found.
+// BinarySearch 在已排序切片中返回 v 的索引，未找到则触发 panic。
+func BinarySearch[T cmp.Ordered](a []T, v T) int {
+	idx, found := slices.BinarySearch(a, v)
+	if !found {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", utils.Any("v", v), zap.Int("insert_index", idx), zap.Int("len", len(a)))
+	}
+	return idx
+}
+
+// EqualsFunc checks if two slices match element-wise by the eq function, panics with the first mismatch if not.
+// EqualsFunc 使用 eq 函数逐个元素检查两个切片是否相等，不相等则触发 panic 并记录第一个不匹配的位置。
+func EqualsFunc[T1, T2 any](a []T1, b []T2, eq func(T1, T2) bool) {
+	if len(a) != len(b) {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT SAME(SHOULD BE SAME)", zap.Int("len_a", len(a)), zap.Int("len_b", len(b)))
+	}
+	for idx := range a {
+		if !eq(a[idx], b[idx]) {
+			zaplog.ZAPS.Skip1.LOG.Panic("NOT SAME(SHOULD BE SAME)", zap.Int("index", idx), utils.Any("a", a[idx]), utils.Any("b", b[idx]), zap.Int("len", len(a)))
+		}
+	}
+}
+
+// ContainsFunc checks if the slice contains an element satisfying the predicate, panics if not.
+// ContainsFunc 检查切片是否包含满足断言函数的元素，不包含则触发 panic。
+func ContainsFunc[T any](a []T, pred func(T) bool) {
+	if !slices.ContainsFunc(a, pred) {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Int("len", len(a)))
+	}
+}
+
+// IndexFunc returns the index of the first element satisfying the predicate, panics if nothing matches.
+// IndexFunc 返回第一个满足断言函数的元素索引，没有匹配则触发 panic。
+func IndexFunc[T any](a []T, pred func(T) bool) int {
+	idx := slices.IndexFunc(a, pred)
+	if idx < 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("VALUE NOT IN SLICE(SHOULD BE IN)", zap.Int("len", len(a)))
+	}
+	return idx
+}
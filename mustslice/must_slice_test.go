@@ -8,9 +8,11 @@
 package mustslice_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/internal/tests"
 	"github.com/yyle88/must/mustslice"
 )
 
@@ -166,3 +168,330 @@ func TestLen(t *testing.T) {
 		mustslice.Len([]string{"a", "b"}, 3)
 	})
 }
+
+// TestAll tests every-element predicate assertion
+// Validates All passes when each element matches and panics when one element does not
+//
+// TestAll 测试所有元素断言函数断言
+// 验证 All 在每个元素都匹配时通过，在有元素不匹配时 panic
+func TestAll(t *testing.T) {
+	mustslice.All([]int{2, 4, 6}, func(v int) bool { return v%2 == 0 })
+	mustslice.All([]int{}, func(v int) bool { return false })
+
+	require.Panics(t, func() {
+		mustslice.All([]int{2, 3, 6}, func(v int) bool { return v%2 == 0 })
+	})
+}
+
+// TestAny tests some-element predicate assertion
+// Validates Any passes when one element matches and panics when none match
+//
+// TestAny 测试任一元素断言函数断言
+// 验证 Any 在有元素匹配时通过，在都不匹配时 panic
+func TestAny(t *testing.T) {
+	mustslice.Any([]string{"a", "bb", "c"}, func(s string) bool { return len(s) == 2 })
+
+	require.Panics(t, func() {
+		mustslice.Any([]string{"a", "b"}, func(s string) bool { return len(s) == 2 })
+	})
+
+	require.Panics(t, func() {
+		mustslice.Any([]string{}, func(s string) bool { return true })
+	})
+}
+
+// TestNoneMatch tests no-element predicate assertion
+// Validates NoneMatch passes when no element matches and panics when one element matches
+//
+// TestNoneMatch 测试无元素匹配断言
+// 验证 NoneMatch 在没有元素匹配时通过，在有元素匹配时 panic
+func TestNoneMatch(t *testing.T) {
+	mustslice.NoneMatch([]int{1, 3, 5}, func(v int) bool { return v%2 == 0 })
+
+	require.Panics(t, func() {
+		mustslice.NoneMatch([]int{1, 4, 5}, func(v int) bool { return v%2 == 0 })
+	})
+}
+
+// TestCountEq tests matching-element count assertion
+// Validates CountEq passes when the count matches and panics on mismatch
+//
+// TestCountEq 测试匹配元素数量断言
+// 验证 CountEq 在数量匹配时通过，在不匹配时 panic
+func TestCountEq(t *testing.T) {
+	mustslice.CountEq([]int{1, 2, 3, 4}, func(v int) bool { return v > 2 }, 2)
+	mustslice.CountEq([]int{1, 2}, func(v int) bool { return v > 2 }, 0)
+
+	require.Panics(t, func() {
+		mustslice.CountEq([]int{1, 2, 3, 4}, func(v int) bool { return v > 2 }, 3)
+	})
+}
+
+// TestFind tests first-matching element lookup
+// Validates Find returns the first matching element and panics when nothing matches
+//
+// TestFind 测试查找第一个匹配元素
+// 验证 Find 返回第一个匹配的元素，在没有匹配时 panic
+func TestFind(t *testing.T) {
+	type account struct {
+		ID   int
+		Name string
+	}
+	accounts := []account{{1, "a"}, {2, "b"}, {3, "b"}}
+	require.Equal(t, account{2, "b"}, mustslice.Find(accounts, func(v account) bool { return v.Name == "b" }))
+
+	require.Panics(t, func() {
+		mustslice.Find(accounts, func(v account) bool { return v.Name == "c" })
+	})
+}
+
+// TestUnique tests no-duplicates assertion
+// Validates Unique returns slices without duplicates and panics when duplicates exist
+//
+// TestUnique 测试无重复断言
+// 验证 Unique 返回无重复的切片，在存在重复时 panic
+func TestUnique(t *testing.T) {
+	require.Equal(t, []int{1, 2, 3}, mustslice.Unique([]int{1, 2, 3}))
+	mustslice.Unique([]string{})
+
+	require.Panics(t, func() {
+		mustslice.Unique([]string{"a", "b", "a"})
+	})
+}
+
+// TestUnique_Duplicates tests the duplicates logged when Unique fails
+// Validates each duplicate is logged once with its count of occurrences
+//
+// TestUnique_Duplicates 测试 Unique 失败时记录的重复元素
+// 验证每个重复元素只记录一次，并附带其出现次数
+func TestUnique_Duplicates(t *testing.T) {
+	logs := tests.ObserveLogs(t)
+
+	require.Panics(t, func() {
+		mustslice.Unique([]string{"a", "b", "a", "c", "a", "b"})
+	})
+	require.Equal(t, 1, logs.Len())
+	require.Equal(t, []any{
+		map[string]any{"value": "a", "count": 3},
+		map[string]any{"value": "b", "count": 2},
+	}, logs.All()[0].ContextMap()["duplicates"])
+}
+
+// TestElementsMatch tests multiset equality assertion
+// Validates ElementsMatch passes when elements match ignoring order and panics on missing / extra elements
+//
+// TestElementsMatch 测试多重集合相等断言
+// 验证 ElementsMatch 在忽略顺序元素一致时通过，在有缺失/多余元素时 panic
+func TestElementsMatch(t *testing.T) {
+	mustslice.ElementsMatch([]int{1, 2, 2, 3}, []int{3, 2, 1, 2})
+	mustslice.ElementsMatch([]int{}, nil)
+
+	require.Panics(t, func() {
+		mustslice.ElementsMatch([]int{1, 2, 2}, []int{1, 2})
+	})
+
+	require.Panics(t, func() {
+		mustslice.ElementsMatch([]string{"a", "b"}, []string{"a", "c"})
+	})
+}
+
+// TestSubset tests subset assertion
+// Validates Subset passes when each element is in the slice and panics when elements are missing
+//
+// TestSubset 测试子集断言
+// 验证 Subset 在每个元素都在切片中时通过，在有缺失元素时 panic
+func TestSubset(t *testing.T) {
+	mustslice.Subset([]int{1, 3}, []int{1, 2, 3})
+	mustslice.Subset([]int{}, []int{1})
+
+	require.Panics(t, func() {
+		mustslice.Subset([]int{1, 4}, []int{1, 2, 3})
+	})
+}
+
+// TestSuperset tests superset assertion
+// Validates Superset passes when the slice contains each element and panics when elements are missing
+//
+// TestSuperset 测试超集断言
+// 验证 Superset 在切片包含每个元素时通过，在有缺失元素时 panic
+func TestSuperset(t *testing.T) {
+	mustslice.Superset([]string{"a", "b", "c"}, []string{"c", "a"})
+
+	require.Panics(t, func() {
+		mustslice.Superset([]string{"a", "b"}, []string{"c"})
+	})
+}
+
+// TestDisjoint tests no-common-elements assertion
+// Validates Disjoint passes when slices share no elements and panics when they do
+//
+// TestDisjoint 测试无共同元素断言
+// 验证 Disjoint 在切片没有共同元素时通过，在有共同元素时 panic
+func TestDisjoint(t *testing.T) {
+	mustslice.Disjoint([]int{1, 2}, []int{3, 4})
+	mustslice.Disjoint([]int{}, []int{3, 4})
+
+	require.Panics(t, func() {
+		mustslice.Disjoint([]int{1, 2, 3}, []int{3, 4})
+	})
+}
+
+// TestIndex tests index-safe element access
+// Validates Index returns the element in range and panics when the index is out of range
+//
+// TestIndex 测试索引安全的元素访问
+// 验证 Index 在范围内时返回元素，在索引越界时 panic
+func TestIndex(t *testing.T) {
+	require.Equal(t, "b", mustslice.Index([]string{"a", "b", "c"}, 1))
+
+	require.Panics(t, func() {
+		mustslice.Index([]string{"a", "b", "c"}, 3)
+	})
+
+	require.Panics(t, func() {
+		mustslice.Index([]int{1}, -1)
+	})
+}
+
+// TestFirst tests first element access
+// Validates First returns the first element and panics with empty slices
+//
+// TestFirst 测试第一个元素访问
+// 验证 First 返回第一个元素，在空切片时 panic
+func TestFirst(t *testing.T) {
+	require.Equal(t, 1, mustslice.First([]int{1, 2, 3}))
+
+	require.Panics(t, func() {
+		mustslice.First([]int{})
+	})
+}
+
+// TestLast tests last element access
+// Validates Last returns the last element and panics with empty slices
+//
+// TestLast 测试最后一个元素访问
+// 验证 Last 返回最后一个元素，在空切片时 panic
+func TestLast(t *testing.T) {
+	require.Equal(t, 3, mustslice.Last([]int{1, 2, 3}))
+
+	require.Panics(t, func() {
+		mustslice.Last([]int(nil))
+	})
+}
+
+// TestOnly tests sole element access
+// Validates Only returns the element of single-element slices and panics otherwise
+//
+// TestOnly 测试唯一元素访问
+// 验证 Only 返回单元素切片的元素，否则 panic
+func TestOnly(t *testing.T) {
+	require.Equal(t, "a", mustslice.Only([]string{"a"}))
+
+	require.Panics(t, func() {
+		mustslice.Only([]string{})
+	})
+
+	require.Panics(t, func() {
+		mustslice.Only([]string{"a", "b"})
+	})
+}
+
+// TestSortedFunc tests sortedness assertion with custom comparator
+// Validates SortedFunc passes when sorted by the comparator and panics on out-of-order pairs
+//
+// TestSortedFunc 测试带自定义比较器的有序断言
+// 验证 SortedFunc 在按比较器有序时通过，在存在乱序对时 panic
+func TestSortedFunc(t *testing.T) {
+	type item struct {
+		Name string
+		Rank int
+	}
+	byRank := func(x, y item) int { return x.Rank - y.Rank }
+
+	mustslice.SortedFunc([]item{{"a", 1}, {"b", 2}, {"c", 2}}, byRank)
+	mustslice.SortedFunc([]item{}, byRank)
+
+	require.Panics(t, func() {
+		mustslice.SortedFunc([]item{{"a", 1}, {"b", 3}, {"c", 2}}, byRank)
+	})
+}
+
+// TestIsSortedBy tests sortedness assertion by key
+// Validates IsSortedBy passes when keys are ascending and panics on out-of-order pairs
+//
+// TestIsSortedBy 测试按键的有序断言
+// 验证 IsSortedBy 在键升序时通过，在存在乱序对时 panic
+func TestIsSortedBy(t *testing.T) {
+	type item struct {
+		Name string
+		Rank int
+	}
+
+	mustslice.IsSortedBy([]item{{"b", 1}, {"a", 2}}, func(v item) int { return v.Rank })
+	mustslice.IsSortedBy([]item{{"a", 2}, {"b", 1}}, func(v item) string { return v.Name })
+
+	require.Panics(t, func() {
+		mustslice.IsSortedBy([]item{{"b", 1}, {"a", 2}}, func(v item) string { return v.Name })
+	})
+}
+
+// TestBinarySearch tests sorted slice lookup
+// Validates BinarySearch returns the index when found and panics when missing
+//
+// TestBinarySearch 测试有序切片查找
+// 验证 BinarySearch 在找到时返回索引，在缺失时 panic
+func TestBinarySearch(t *testing.T) {
+	require.Equal(t, 2, mustslice.BinarySearch([]int{1, 3, 5, 7}, 5))
+	require.Equal(t, 0, mustslice.BinarySearch([]string{"a", "b"}, "a"))
+
+	require.Panics(t, func() {
+		mustslice.BinarySearch([]int{1, 3, 5, 7}, 4)
+	})
+}
+
+// TestEqualsFunc tests element-wise slice equality with custom function
+// Validates EqualsFunc passes when elements match by the function and panics on mismatch
+//
+// TestEqualsFunc 测试使用自定义函数的逐元素切片相等断言
+// 验证 EqualsFunc 在元素按函数匹配时通过，在不匹配时 panic
+func TestEqualsFunc(t *testing.T) {
+	eq := func(a, b []string) bool { return slices.Equal(a, b) }
+
+	mustslice.EqualsFunc([][]string{{"a"}, {"b", "c"}}, [][]string{{"a"}, {"b", "c"}}, eq)
+
+	require.Panics(t, func() {
+		mustslice.EqualsFunc([][]string{{"a"}, {"b", "c"}}, [][]string{{"a"}, {"b"}}, eq)
+	})
+
+	require.Panics(t, func() {
+		mustslice.EqualsFunc([][]string{{"a"}}, [][]string{{"a"}, {"b"}}, eq)
+	})
+}
+
+// TestContainsFunc tests slice contains matching element assertion
+// Validates ContainsFunc passes when an element matches and panics when none match
+//
+// TestContainsFunc 测试切片包含匹配元素断言
+// 验证 ContainsFunc 在有元素匹配时通过，在都不匹配时 panic
+func TestContainsFunc(t *testing.T) {
+	data := [][]byte{[]byte("a"), []byte("bc")}
+
+	mustslice.ContainsFunc(data, func(v []byte) bool { return string(v) == "bc" })
+
+	require.Panics(t, func() {
+		mustslice.ContainsFunc(data, func(v []byte) bool { return string(v) == "d" })
+	})
+}
+
+// TestIndexFunc tests matching element index lookup
+// Validates IndexFunc returns the index of the first match and panics when none match
+//
+// TestIndexFunc 测试匹配元素索引查找
+// 验证 IndexFunc 返回第一个匹配的索引，在都不匹配时 panic
+func TestIndexFunc(t *testing.T) {
+	require.Equal(t, 1, mustslice.IndexFunc([]int{1, 4, 6}, func(v int) bool { return v%2 == 0 }))
+
+	require.Panics(t, func() {
+		mustslice.IndexFunc([]int{1, 3}, func(v int) bool { return v%2 == 0 })
+	})
+}
@@ -1,17 +1,18 @@
 // Package muststrings provides string-specific assertion utilities with panic-on-failure semantics
 // Implements validation functions using Go standard strings package
-// Supports length checking, prefix/suffix validation, and substring containment testing
+// Supports length checking, prefix/suffix validation, substring containment and case-insensitive matching
 // Integrates with zap structured logging to provide detailed context when assertions are not met
 //
 // muststrings 提供字符串特定的断言工具，带 panic-on-failure 语义
 // 使用 Go 标准 strings 包实现字符串操作的验证函数
-// 支持长度检查、前缀/后缀验证和子串包含性测试
+// 支持长度检查、前缀/后缀验证、子串包含性测试和忽略大小写匹配
 // 与 zap 结构化日志集成，当断言不满足时提供详细上下文
 package muststrings
 
 import (
 	"strings"
 
+	"github.com/yyle88/must/internal/utils"
 	"github.com/yyle88/zaplog"
 	"go.uber.org/zap"
 )
@@ -79,3 +80,69 @@ func NotContains(a string, sub string) {
 		zaplog.ZAPS.Skip1.LOG.Panic("STRING HAS SUBSTRING(SHOULD NOT HAVE SUBSTRING)", zap.String("string", a), zap.String("substring", sub))
 	}
 }
+
+// EqualFold checks if the two strings match ignoring case, panics if not.
+// EqualFold 检查两个字符串在忽略大小写时是否相等，不相等则触发 panic。
+func EqualFold(a string, b string) {
+	if !strings.EqualFold(a, b) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRINGS NOT EQUAL FOLD(SHOULD BE SAME IGNORING CASE)", zap.String("a", a), zap.String("b", b))
+	}
+}
+
+// HasPrefixFold checks if the string has the specified prefix ignoring case, panics if not.
+// HasPrefixFold 检查字符串在忽略大小写时是否有指定的前缀，没有则触发 panic。
+func HasPrefixFold(a string, prefix string) {
+	if !utils.HasPrefixFold(a, prefix) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING PREFIX FOLD(SHOULD HAVE PREFIX IGNORING CASE)", zap.String("string", a), zap.String("prefix", prefix))
+	}
+}
+
+// ContainsFold checks if the string contains the specified substring ignoring case, panics if not.
+// ContainsFold 检查字符串在忽略大小写时是否包含指定的子串，没有则触发 panic。
+func ContainsFold(a string, sub string) {
+	if !utils.ContainsFold(a, sub) {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING SUBSTRING FOLD(SHOULD HAVE SUBSTRING IGNORING CASE)", zap.String("string", a), zap.String("substring", sub))
+	}
+}
+
+// OneOf checks if the string matches one of the candidates, panics if not. Returns the string when matched.
+// OneOf 检查字符串是否等于候选值之一，不是则触发 panic。匹配时返回该字符串。
+func OneOf(a string, candidates ...string) string {
+	for _, candidate := range candidates {
+		if a == candidate {
+			return a
+		}
+	}
+	zaplog.ZAPS.Skip1.LOG.Panic("STRING NOT ONE OF CANDIDATES(SHOULD BE ONE OF)", zap.String("string", a), zap.Strings("candidates", candidates))
+	return a
+}
+
+// NotBlank checks if the string is non-empty after trimming whitespace, panics if blank. Returns the string when not blank.
+// NotBlank 检查字符串去除空白后是否非空，为空白则触发 panic。非空白时返回该字符串。
+func NotBlank(a string) string {
+	if strings.TrimSpace(a) == "" {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING IS BLANK(SHOULD NOT BE BLANK)", zap.String("string", a), zap.Int("len", len(a)))
+	}
+	return a
+}
+
+// ContainsAny checks if the string contains at least one of the substrings, panics if none found.
+// Note: unlike strings.ContainsAny, the candidates are substrings, not a set of chars.
+// ContainsAny 检查字符串是否至少包含其中一个子串，都不包含则触发 panic。
+// 注意：与 strings.ContainsAny 不同，候选值是子串，而不是字符集合。
+func ContainsAny(a string, subs ...string) {
+	for _, sub := range subs {
+		if strings.Contains(a, sub) {
+			return
+		}
+	}
+	zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING ALL SUBSTRINGS(SHOULD HAVE ANY SUBSTRING)", zap.String("string", a), zap.Strings("candidates", subs))
+}
+
+// ContainsAll checks if the string contains each of the substrings, panics if any is missing.
+// ContainsAll 检查字符串是否包含所有子串，有缺失则触发 panic。
+func ContainsAll(a string, subs ...string) {
+	if missing := utils.MissingSubstrings(a, subs); len(missing) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("STRING MISSING SUBSTRINGS(SHOULD HAVE ALL SUBSTRINGS)", zap.String("string", a), zap.Strings("missing", utils.Pick(subs, missing)), zap.Strings("candidates", subs))
+	}
+}
@@ -0,0 +1,244 @@
+package muststrings
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// DefaultDiffBudget is the default size (in bytes) of the diff logged when Same fails
+// DefaultDiffBudget 是 Same 失败时记录的差异文本的默认大小（字节）
+const DefaultDiffBudget = 4096
+
+// diffBudget holds the budget set by SetDiffBudget, nil means DefaultDiffBudget
+// diffBudget 保存通过 SetDiffBudget 设置的预算，nil 表示 DefaultDiffBudget
+var diffBudget atomic.Pointer[int]
+
+// SetDiffBudget sets the size (in bytes) of the diff logged when Same fails, including the truncation note. Values <= 0 mean no limit.
+// SetDiffBudget 设置 Same 失败时记录的差异文本大小（字节），包含截断说明。小于等于 0 表示不限制。
+func SetDiffBudget(budget int) {
+	diffBudget.Store(&budget)
+}
+
+// currentDiffBudget returns the budget set by SetDiffBudget, DefaultDiffBudget when not set
+// currentDiffBudget 返回通过 SetDiffBudget 设置的预算，未设置时返回 DefaultDiffBudget
+func currentDiffBudget() int {
+	if p := diffBudget.Load(); p != nil {
+		return *p
+	}
+	return DefaultDiffBudget
+}
+
+// diffContext is the count of unchanged lines kept around each change in the diff
+// diffContext 是差异中每处变更前后保留的未变更行数
+const diffContext = 2
+
+// caretWindow is the count of runes kept before the first differing column in the caret marker
+// caretWindow 是插入符标记中第一个不同列之前保留的字符数
+const caretWindow = 40
+
+// Same expects the strings to match. Panics if not matching, logging a line diff with the first differing line/column.
+// Same 期望字符串相等。如果不相等则触发 panic，并记录行差异以及第一个不同的行/列。
+func Same(a string, b string) {
+	if a != b {
+		line, column, caret := firstDifference(a, b)
+		zaplog.ZAPS.Skip1.LOG.Panic("STRINGS NOT SAME(SHOULD BE SAME)",
+			zap.Int("line", line),
+			zap.Int("column", column),
+			zap.String("caret", caret),
+			zap.String("diff", truncate(lineDiff(a, b), currentDiffBudget())),
+		)
+	}
+}
+
+// firstDifference locates the first differing line and column (both 1-based, column counted in runes)
+// Returns the two differing lines with a caret marker pointing at the column
+//
+// firstDifference 定位第一个不同的行和列（均从 1 开始，列按字符计数）
+// 返回两个不同的行以及指向该列的插入符标记
+func firstDifference(a string, b string) (int, int, string) {
+	linesA := strings.Split(a, "\n")
+	linesB := strings.Split(b, "\n")
+	idx := 0
+	for idx < len(linesA) && idx < len(linesB) && linesA[idx] == linesB[idx] {
+		idx++
+	}
+	var lineA, lineB string
+	if idx < len(linesA) {
+		lineA = linesA[idx]
+	}
+	if idx < len(linesB) {
+		lineB = linesB[idx]
+	}
+	runesA := []rune(lineA)
+	runesB := []rune(lineB)
+	col := 0
+	for col < len(runesA) && col < len(runesB) && runesA[col] == runesB[col] {
+		col++
+	}
+	start := 0
+	if col > caretWindow {
+		start = col - caretWindow
+	}
+	caret := "a: " + clip(runesA, start) + "\n" +
+		"b: " + clip(runesB, start) + "\n" +
+		"   " + strings.Repeat(" ", col-start) + "^"
+	return idx + 1, col + 1, caret
+}
+
+// clip renders the runes from start, prefixing "..." when leading runes are dropped and limiting the tail
+// clip 从 start 开始渲染字符，丢弃前导字符时加 "..." 前缀，并限制尾部长度
+func clip(runes []rune, start int) string {
+	if start > len(runes) {
+		start = len(runes)
+	}
+	end := len(runes)
+	if end-start > 3*caretWindow {
+		end = start + 3*caretWindow
+	}
+	res := string(runes[start:end])
+	if start > 0 {
+		res = "..." + res
+	}
+	if end < len(runes) {
+		res += "..."
+	}
+	return res
+}
+
+// lineDiff renders a unified-style line diff of a and b, keeping diffContext lines around each change
+// Each hunk header shows the starting line in a and in b, as "@@ -a +b @@"
+//
+// lineDiff 渲染 a 和 b 的统一格式行差异，在每处变更前后保留 diffContext 行
+// 每个块头显示其在 a 和 b 中的起始行，形如 "@@ -a +b @@"
+func lineDiff(a string, b string) string {
+	linesA := strings.Split(a, "\n")
+	linesB := strings.Split(b, "\n")
+
+	type edit struct {
+		op    byte // ' ', '-', '+'
+		text  string
+		lineA int // 1-based line number in a, the next line of a for '+'
+		lineB int // 1-based line number in b, the next line of b for '-'
+	}
+
+	// trim common prefix and suffix, then compute LCS on the middle part
+	prefix := 0
+	for prefix < len(linesA) && prefix < len(linesB) && linesA[prefix] == linesB[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(linesA)-prefix && suffix < len(linesB)-prefix && linesA[len(linesA)-1-suffix] == linesB[len(linesB)-1-suffix] {
+		suffix++
+	}
+	midA := linesA[prefix : len(linesA)-suffix]
+	midB := linesB[prefix : len(linesB)-suffix]
+
+	var edits []edit
+	for i := 0; i < prefix; i++ {
+		edits = append(edits, edit{op: ' ', text: linesA[i], lineA: i + 1, lineB: i + 1})
+	}
+	if len(midA)*len(midB) > 1<<20 {
+		// too large to align, show the removed block then the added block
+		for i, s := range midA {
+			edits = append(edits, edit{op: '-', text: s, lineA: prefix + i + 1, lineB: prefix + 1})
+		}
+		for j, s := range midB {
+			edits = append(edits, edit{op: '+', text: s, lineA: prefix + len(midA) + 1, lineB: prefix + j + 1})
+		}
+	} else {
+		lcs := make([][]int, len(midA)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(midB)+1)
+		}
+		for i := len(midA) - 1; i >= 0; i-- {
+			for j := len(midB) - 1; j >= 0; j-- {
+				if midA[i] == midB[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < len(midA) || j < len(midB) {
+			switch {
+			case i < len(midA) && j < len(midB) && midA[i] == midB[j]:
+				edits = append(edits, edit{op: ' ', text: midA[i], lineA: prefix + i + 1, lineB: prefix + j + 1})
+				i++
+				j++
+			case j >= len(midB) || (i < len(midA) && lcs[i+1][j] >= lcs[i][j+1]):
+				edits = append(edits, edit{op: '-', text: midA[i], lineA: prefix + i + 1, lineB: prefix + j + 1})
+				i++
+			default:
+				edits = append(edits, edit{op: '+', text: midB[j], lineA: prefix + i + 1, lineB: prefix + j + 1})
+				j++
+			}
+		}
+	}
+	for k := suffix; k > 0; k-- {
+		edits = append(edits, edit{op: ' ', text: linesA[len(linesA)-k], lineA: len(linesA) - k + 1, lineB: len(linesB) - k + 1})
+	}
+
+	// keep only the changes and their surrounding context
+	keep := make([]bool, len(edits))
+	for idx, e := range edits {
+		if e.op != ' ' {
+			for k := max(0, idx-diffContext); k <= min(len(edits)-1, idx+diffContext); k++ {
+				keep[k] = true
+			}
+		}
+	}
+	var sb strings.Builder
+	sb.WriteString("--- a\n+++ b\n")
+	skipped := false
+	for idx, e := range edits {
+		if !keep[idx] {
+			skipped = true
+			continue
+		}
+		if skipped || idx == 0 {
+			sb.WriteString(fmt.Sprintf("@@ -%d +%d @@\n", e.lineA, e.lineB))
+			skipped = false
+		}
+		sb.WriteByte(e.op)
+		sb.WriteByte(' ')
+		sb.WriteString(e.text)
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// truncate limits the text to budget bytes without splitting a rune, noting the count of dropped bytes within the budget
+// The note is left out when the budget is too small to hold it
+//
+// truncate 将文本限制在 budget 字节内且不截断字符，并在预算内注明丢弃的字节数
+// 当预算小到放不下说明时省略说明
+func truncate(s string, budget int) string {
+	if budget <= 0 || len(s) <= budget {
+		return s
+	}
+	// the note of dropping the whole text is the longest one, reserving it keeps any shorter note within the budget
+	cut := budget - len(truncateNote(len(s)))
+	note := true
+	if cut < 0 {
+		cut, note = budget, false
+	}
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	if !note {
+		return s[:cut]
+	}
+	return s[:cut] + truncateNote(len(s)-cut)
+}
+
+// truncateNote notes the count of bytes dropped by truncate
+// truncateNote 注明 truncate 丢弃的字节数
+func truncateNote(dropped int) string {
+	return fmt.Sprintf("\n... (truncated %d bytes)", dropped)
+}
@@ -0,0 +1,78 @@
+package muststrings
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFirstDifference tests locating the first differing line and column
+// Validates the line/column are 1-based and the caret points at the column
+//
+// TestFirstDifference 测试定位第一个不同的行和列
+// 验证行/列从 1 开始，且插入符指向该列
+func TestFirstDifference(t *testing.T) {
+	line, column, caret := firstDifference("a\nid = 1\nc", "a\nid = 2\nc")
+	require.Equal(t, 2, line)
+	require.Equal(t, 6, column)
+	require.Equal(t, "a: id = 1\nb: id = 2\n        ^", caret)
+
+	line, column, _ = firstDifference("abc", "abc\nd")
+	require.Equal(t, 2, line)
+	require.Equal(t, 1, column)
+}
+
+// TestFirstDifference_LongLine tests the caret window on long single-line values
+// Validates the leading runes are dropped and marked with "..."
+//
+// TestFirstDifference_LongLine 测试长单行值的插入符窗口
+// 验证前导字符被丢弃并以 "..." 标记
+func TestFirstDifference_LongLine(t *testing.T) {
+	head := strings.Repeat("x", 100)
+	_, column, caret := firstDifference(head+"a", head+"b")
+	require.Equal(t, 101, column)
+	rows := strings.Split(caret, "\n")
+	require.Equal(t, "a: ..."+strings.Repeat("x", caretWindow)+"a", rows[0])
+	require.Equal(t, "   "+strings.Repeat(" ", caretWindow)+"^", rows[2])
+}
+
+// TestLineDiff tests unified-style line diff rendering
+// Validates changed lines are marked, distant unchanged lines are skipped and hunk headers show the lines in a and b
+//
+// TestLineDiff 测试统一格式行差异渲染
+// 验证变更行被标记，远处未变更的行被跳过，块头显示 a 和 b 中的行号
+func TestLineDiff(t *testing.T) {
+	a := "1\n2\n3\n4\n5\n6\n7"
+	b := "1\n2\n3\n4\nfive\n6\n7"
+	require.Equal(t, "--- a\n+++ b\n@@ -3 +3 @@\n  3\n  4\n- 5\n+ five\n  6\n  7\n", lineDiff(a, b))
+
+	require.Equal(t, "--- a\n+++ b\n@@ -1 +1 @@\n  x\n+ y\n", lineDiff("x", "x\ny"))
+
+	a = "1\n2\n3\n4\n5\n6\n7\n8"
+	b = "0\n1\n2\n3\n4\n5\n6\nseven\n8"
+	require.Equal(t, "--- a\n+++ b\n@@ -1 +1 @@\n+ 0\n  1\n  2\n@@ -5 +6 @@\n  5\n  6\n- 7\n+ seven\n  8\n", lineDiff(a, b))
+}
+
+// TestTruncate tests limiting the diff text to the budget
+// Validates text within budget is kept and longer text is cut with a note, the note counting within the budget
+//
+// TestTruncate 测试将差异文本限制在预算内
+// 验证预算内的文本保持不变，更长的文本被截断并附注，说明也计入预算
+func TestTruncate(t *testing.T) {
+	require.Equal(t, "abc", truncate("abc", 3))
+	require.Equal(t, "abc", truncate("abc", 0))
+	require.Equal(t, "abc", truncate("abc", -1))
+
+	res := truncate(strings.Repeat("x", 100), 40)
+	require.Equal(t, strings.Repeat("x", 14)+"\n... (truncated 86 bytes)", res)
+	require.LessOrEqual(t, len(res), 40)
+
+	res = truncate(strings.Repeat("世", 40), 40)
+	require.Equal(t, strings.Repeat("世", 4)+"\n... (truncated 108 bytes)", res)
+	require.LessOrEqual(t, len(res), 40)
+
+	// too small to hold the note
+	require.Equal(t, "ab", truncate("abc", 2))
+	require.Equal(t, "a", truncate("a世", 2))
+}
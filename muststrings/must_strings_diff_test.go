@@ -0,0 +1,52 @@
+package muststrings_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/internal/tests"
+	"github.com/yyle88/must/muststrings"
+)
+
+// TestSame tests string sameness assertion with diff output
+// Validates Same passes when strings match and panics when strings differ
+//
+// TestSame 测试带差异输出的字符串相同断言
+// 验证 Same 在字符串相同时通过，在字符串不同时 panic
+func TestSame(t *testing.T) {
+	muststrings.Same("SELECT 1\nFROM t", "SELECT 1\nFROM t")
+
+	require.Panics(t, func() {
+		muststrings.Same("SELECT 1\nFROM t", "SELECT 1\nFROM x")
+	})
+}
+
+// TestSetDiffBudget tests changing the size of the logged diff
+// Validates the diff stays within the budget including the note, and budgets <= 0 log the whole diff
+//
+// TestSetDiffBudget 测试修改记录的差异文本大小
+// 验证差异文本（含截断说明）不超过预算，小于等于 0 的预算记录完整差异
+func TestSetDiffBudget(t *testing.T) {
+	t.Cleanup(func() { muststrings.SetDiffBudget(muststrings.DefaultDiffBudget) })
+	logs := tests.ObserveLogs(t)
+
+	a := strings.Repeat("line a\n", 50)
+	b := strings.Repeat("line b\n", 50)
+
+	muststrings.SetDiffBudget(100)
+	require.Panics(t, func() {
+		muststrings.Same(a, b)
+	})
+	diff := logs.TakeAll()[0].ContextMap()["diff"].(string)
+	require.LessOrEqual(t, len(diff), 100)
+	require.Contains(t, diff, "... (truncated ")
+
+	muststrings.SetDiffBudget(0)
+	require.Panics(t, func() {
+		muststrings.Same(a, b)
+	})
+	diff = logs.TakeAll()[0].ContextMap()["diff"].(string)
+	require.NotContains(t, diff, "... (truncated ")
+	require.Equal(t, 100, strings.Count(diff, "line "))
+}
@@ -117,3 +117,115 @@ func TestNotContains(t *testing.T) {
 		muststrings.NotContains("hello world", "world")
 	})
 }
+
+// TestEqualFold tests case-insensitive string equality assertion
+// Validates EqualFold passes when strings match ignoring case and panics when they differ
+//
+// TestEqualFold 测试忽略大小写的字符串相等断言
+// 验证 EqualFold 在忽略大小写相等时通过，在不同时 panic
+func TestEqualFold(t *testing.T) {
+	muststrings.EqualFold("Content-Type", "content-type")
+	muststrings.EqualFold("APPLICATION/JSON", "application/json")
+
+	require.Panics(t, func() {
+		muststrings.EqualFold("gzip", "deflate")
+	})
+}
+
+// TestHasPrefixFold tests case-insensitive prefix presence assertion
+// Validates HasPrefixFold passes when prefix matches ignoring case and panics when not found
+//
+// TestHasPrefixFold 测试忽略大小写的前缀存在断言
+// 验证 HasPrefixFold 在忽略大小写前缀匹配时通过，在前缀不存在时 panic
+func TestHasPrefixFold(t *testing.T) {
+	muststrings.HasPrefixFold("Bearer abc", "bearer ")
+	muststrings.HasPrefixFold("ΣΑΣ", "σα")
+	muststrings.HasPrefixFold("abc", "")
+
+	require.Panics(t, func() {
+		muststrings.HasPrefixFold("Basic abc", "bearer")
+	})
+
+	require.Panics(t, func() {
+		muststrings.HasPrefixFold("be", "bearer")
+	})
+}
+
+// TestContainsFold tests case-insensitive substring containment assertion
+// Validates ContainsFold passes when substring matches ignoring case and panics when not found
+//
+// TestContainsFold 测试忽略大小写的子串包含断言
+// 验证 ContainsFold 在忽略大小写包含子串时通过，在子串不存在时 panic
+func TestContainsFold(t *testing.T) {
+	muststrings.ContainsFold("text/HTML; charset=UTF-8", "charset=utf-8")
+	muststrings.ContainsFold("abc", "")
+
+	require.Panics(t, func() {
+		muststrings.ContainsFold("text/html", "json")
+	})
+}
+
+// TestOneOf tests string candidate membership assertion
+// Validates OneOf returns the string when matching a candidate and panics when not matching
+//
+// TestOneOf 测试字符串候选值成员断言
+// 验证 OneOf 在匹配候选值时返回字符串，在不匹配时 panic
+func TestOneOf(t *testing.T) {
+	require.Equal(t, "debug", muststrings.OneOf("debug", "debug", "info", "warn"))
+
+	require.Panics(t, func() {
+		muststrings.OneOf("trace", "debug", "info", "warn")
+	})
+
+	require.Panics(t, func() {
+		muststrings.OneOf("debug")
+	})
+}
+
+// TestNotBlank tests non-blank string assertion
+// Validates NotBlank returns non-blank strings and panics with empty / whitespace-only strings
+//
+// TestNotBlank 测试非空白字符串断言
+// 验证 NotBlank 返回非空白字符串，在空或仅含空白的字符串时 panic
+func TestNotBlank(t *testing.T) {
+	require.Equal(t, " abc ", muststrings.NotBlank(" abc "))
+
+	require.Panics(t, func() {
+		muststrings.NotBlank("")
+	})
+
+	require.Panics(t, func() {
+		muststrings.NotBlank(" \t\n ")
+	})
+}
+
+// TestContainsAny tests any-substring containment assertion
+// Validates ContainsAny passes when one substring is found and panics when none found
+//
+// TestContainsAny 测试任一子串包含断言
+// 验证 ContainsAny 在找到任一子串时通过，在都未找到时 panic
+func TestContainsAny(t *testing.T) {
+	muststrings.ContainsAny("hello world", "planet", "world")
+
+	require.Panics(t, func() {
+		muststrings.ContainsAny("hello world", "planet", "moon")
+	})
+
+	require.Panics(t, func() {
+		muststrings.ContainsAny("hello world")
+	})
+}
+
+// TestContainsAll tests all-substrings containment assertion
+// Validates ContainsAll passes when each substring is found and panics when any is missing
+//
+// TestContainsAll 测试所有子串包含断言
+// 验证 ContainsAll 在所有子串都找到时通过，在有缺失时 panic
+func TestContainsAll(t *testing.T) {
+	muststrings.ContainsAll("hello world", "hello", "world")
+	muststrings.ContainsAll("hello world")
+
+	require.Panics(t, func() {
+		muststrings.ContainsAll("hello world", "hello", "planet")
+	})
+}
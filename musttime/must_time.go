@@ -0,0 +1,96 @@
+// Package musttime provides time and duration assertion utilities with panic-on-failure semantics
+// Implements ordering, range and proximity checks on time.Time and time.Duration values
+// Supports an injectable clock so InFuture and InPast can be tested with fixed times
+// Integrates with zap structured logging, logging times in RFC3339Nano when assertions are not met
+//
+// musttime 提供时间和时长的断言工具，带 panic-on-failure 语义
+// 实现针对 time.Time 和 time.Duration 的先后、范围和接近程度检查
+// 支持可注入的时钟，使 InFuture 和 InPast 可以使用固定时间测试
+// 与 zap 结构化日志集成，当断言不满足时以 RFC3339Nano 格式记录时间
+package musttime
+
+import (
+	"time"
+
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// Before expects a to be before b. Panics if a is not before b.
+// Before 期望 a 早于 b。如果 a 不早于 b 则触发 panic。
+func Before(a, b time.Time) {
+	if !a.Before(b) {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT BEFORE(SHOULD BE BEFORE)", timeField("a", a), timeField("b", b), zap.Duration("delta", a.Sub(b)))
+	}
+}
+
+// After expects a to be after b. Panics if a is not after b.
+// After 期望 a 晚于 b。如果 a 不晚于 b 则触发 panic。
+func After(a, b time.Time) {
+	if !a.After(b) {
+		zaplog.ZAPS.Skip1.LOG.Panic("NOT AFTER(SHOULD BE AFTER)", timeField("a", a), timeField("b", b), zap.Duration("delta", a.Sub(b)))
+	}
+}
+
+// Between expects t to be within [start, end], both ends inclusive. Panics if t is outside the range.
+// Between 期望 t 在 [start, end] 范围内，两端均包含。如果 t 超出范围则触发 panic。
+func Between(t, start, end time.Time) {
+	if t.Before(start) || t.After(end) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TIME OUT OF RANGE(SHOULD BE BETWEEN)", timeField("t", t), timeField("start", start), timeField("end", end))
+	}
+}
+
+// WithinDuration expects a and b to differ by at most d. Panics if they are further apart.
+// WithinDuration 期望 a 与 b 相差不超过 d。如果相差更大则触发 panic。
+func WithinDuration(a, b time.Time, d time.Duration) {
+	delta := a.Sub(b)
+	if delta < -d || delta > d {
+		zaplog.ZAPS.Skip1.LOG.Panic("TIMES TOO FAR APART(SHOULD BE WITHIN DURATION)", timeField("a", a), timeField("b", b), zap.Duration("delta", delta), zap.Duration("d", d))
+	}
+}
+
+// NotZero expects t to be a non-zero time. Panics if t is the zero time.
+// NotZero 期望 t 不是零值时间。如果 t 是零值时间则触发 panic。
+func NotZero(t time.Time) {
+	if t.IsZero() {
+		zaplog.ZAPS.Skip1.LOG.Panic("TIME IS ZERO(SHOULD NOT BE ZERO)", timeField("t", t))
+	}
+}
+
+// InFuture expects t to be after the clock's now. Panics if t is not in the future.
+// InFuture 期望 t 晚于时钟的当前时间。如果 t 不在未来则触发 panic。
+func InFuture(t time.Time) {
+	if now := Now(); !t.After(now) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TIME NOT IN FUTURE(SHOULD BE IN FUTURE)", timeField("t", t), timeField("now", now), zap.Duration("delta", t.Sub(now)))
+	}
+}
+
+// InPast expects t to be before the clock's now. Panics if t is not in the past.
+// InPast 期望 t 早于时钟的当前时间。如果 t 不在过去则触发 panic。
+func InPast(t time.Time) {
+	if now := Now(); !t.Before(now) {
+		zaplog.ZAPS.Skip1.LOG.Panic("TIME NOT IN PAST(SHOULD BE IN PAST)", timeField("t", t), timeField("now", now), zap.Duration("delta", t.Sub(now)))
+	}
+}
+
+// DurationPositive expects d to be positive. Panics if d <= 0.
+// DurationPositive 期望 d 为正数。如果 d <= 0 则触发 panic。
+func DurationPositive(d time.Duration) {
+	if d <= 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("DURATION NOT POSITIVE(SHOULD BE POSITIVE)", zap.Duration("d", d))
+	}
+}
+
+// DurationBetween expects d to be within [lo, hi], both ends inclusive. Panics if d is outside the range.
+// DurationBetween 期望 d 在 [lo, hi] 范围内，两端均包含。如果 d 超出范围则触发 panic。
+func DurationBetween(d, lo, hi time.Duration) {
+	if d < lo || d > hi {
+		zaplog.ZAPS.Skip1.LOG.Panic("DURATION OUT OF RANGE(SHOULD BE BETWEEN)", zap.Duration("d", d), zap.Duration("lo", lo), zap.Duration("hi", hi))
+	}
+}
+
+// timeField renders the time in RFC3339Nano, keeping the location offset
+// timeField 以 RFC3339Nano 格式渲染时间，保留时区偏移
+func timeField(key string, t time.Time) zap.Field {
+	return zap.String(key, t.Format(time.RFC3339Nano))
+}
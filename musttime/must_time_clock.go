@@ -0,0 +1,33 @@
+package musttime
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Clock returns the current time, letting tests replace time.Now with a fixed time
+// Clock 返回当前时间，使测试可以用固定时间替换 time.Now
+type Clock func() time.Time
+
+// clock holds the package-wide clock, nil means time.Now
+// clock 保存包级的时钟，nil 表示 time.Now
+var clock atomic.Pointer[Clock]
+
+// SetClock sets the package-wide clock used by InFuture and InPast, nil restores time.Now.
+// SetClock 设置 InFuture 和 InPast 使用的包级时钟，传 nil 恢复为 time.Now。
+func SetClock(c Clock) {
+	if c == nil {
+		clock.Store(nil)
+		return
+	}
+	clock.Store(&c)
+}
+
+// Now returns the current time of the package-wide clock.
+// Now 返回包级时钟的当前时间。
+func Now() time.Time {
+	if c := clock.Load(); c != nil {
+		return (*c)()
+	}
+	return time.Now()
+}
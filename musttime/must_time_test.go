@@ -0,0 +1,213 @@
+// Package musttime_test provides comprehensive testing of musttime assertion package
+// Tests include time ordering, ranges, proximity, clock-based checks and duration validation
+// Checks each assertion functions with both success and failure cases
+//
+// musttime_test 为 musttime 断言包提供全面的测试
+// 测试涵盖时间先后、范围、接近程度、基于时钟的检查和时长验证
+// 使用成功和失败案例验证所有断言函数
+package musttime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/internal/tests"
+	"github.com/yyle88/must/musttime"
+)
+
+var base = time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+
+// TestBefore tests time ordering assertion
+// Validates Before passes when a is earlier and panics when a is same or later
+//
+// TestBefore 测试时间先后断言
+// 验证 Before 在 a 更早时通过，在 a 相同或更晚时 panic
+func TestBefore(t *testing.T) {
+	musttime.Before(base, base.Add(time.Nanosecond))
+
+	require.Panics(t, func() {
+		musttime.Before(base, base)
+	})
+
+	require.Panics(t, func() {
+		musttime.Before(base.Add(time.Second), base)
+	})
+}
+
+// TestAfter tests time ordering assertion
+// Validates After passes when a is later and panics when a is same or earlier
+//
+// TestAfter 测试时间先后断言
+// 验证 After 在 a 更晚时通过，在 a 相同或更早时 panic
+func TestAfter(t *testing.T) {
+	musttime.After(base.Add(time.Nanosecond), base)
+
+	require.Panics(t, func() {
+		musttime.After(base, base)
+	})
+
+	require.Panics(t, func() {
+		musttime.After(base, base.Add(time.Second))
+	})
+}
+
+// TestBetween tests time range assertion
+// Validates Between passes inside the inclusive range and panics outside it
+//
+// TestBetween 测试时间范围断言
+// 验证 Between 在闭区间内通过，在区间外 panic
+func TestBetween(t *testing.T) {
+	end := base.Add(time.Hour)
+	musttime.Between(base, base, end)
+	musttime.Between(end, base, end)
+	musttime.Between(base.Add(time.Minute), base, end)
+
+	require.Panics(t, func() {
+		musttime.Between(base.Add(-time.Nanosecond), base, end)
+	})
+
+	require.Panics(t, func() {
+		musttime.Between(end.Add(time.Nanosecond), base, end)
+	})
+}
+
+// TestWithinDuration tests time proximity assertion
+// Validates WithinDuration passes when times are close in either direction and panics when too far apart
+//
+// TestWithinDuration 测试时间接近程度断言
+// 验证 WithinDuration 在两个方向上接近时通过，在相差太远时 panic
+func TestWithinDuration(t *testing.T) {
+	musttime.WithinDuration(base, base.Add(time.Second), time.Second)
+	musttime.WithinDuration(base.Add(time.Second), base, time.Second)
+
+	require.Panics(t, func() {
+		musttime.WithinDuration(base, base.Add(2*time.Second), time.Second)
+	})
+
+	require.Panics(t, func() {
+		musttime.WithinDuration(base.Add(2*time.Second), base, time.Second)
+	})
+}
+
+// TestNotZero tests zero time assertion
+// Validates NotZero passes with a set time and panics with the zero time
+//
+// TestNotZero 测试零值时间断言
+// 验证 NotZero 在时间已设置时通过，在零值时间时 panic
+func TestNotZero(t *testing.T) {
+	musttime.NotZero(base)
+
+	require.Panics(t, func() {
+		musttime.NotZero(time.Time{})
+	})
+}
+
+// TestInFuture tests clock-based future assertion
+// Validates InFuture uses the injected clock and panics when the time is not after now
+//
+// TestInFuture 测试基于时钟的未来时间断言
+// 验证 InFuture 使用注入的时钟，在时间不晚于当前时间时 panic
+func TestInFuture(t *testing.T) {
+	musttime.SetClock(func() time.Time { return base })
+	t.Cleanup(func() { musttime.SetClock(nil) })
+
+	musttime.InFuture(base.Add(time.Nanosecond))
+
+	require.Panics(t, func() {
+		musttime.InFuture(base)
+	})
+
+	require.Panics(t, func() {
+		musttime.InFuture(base.Add(-time.Hour))
+	})
+}
+
+// TestInPast tests clock-based past assertion
+// Validates InPast uses the injected clock and panics when the time is not before now
+//
+// TestInPast 测试基于时钟的过去时间断言
+// 验证 InPast 使用注入的时钟，在时间不早于当前时间时 panic
+func TestInPast(t *testing.T) {
+	musttime.SetClock(func() time.Time { return base })
+	t.Cleanup(func() { musttime.SetClock(nil) })
+
+	musttime.InPast(base.Add(-time.Nanosecond))
+
+	require.Panics(t, func() {
+		musttime.InPast(base)
+	})
+
+	require.Panics(t, func() {
+		musttime.InPast(base.Add(time.Hour))
+	})
+}
+
+// TestSetClock tests clock injection
+// Validates SetClock replaces the clock and nil restores time.Now
+//
+// TestSetClock 测试时钟注入
+// 验证 SetClock 替换时钟，传 nil 恢复为 time.Now
+func TestSetClock(t *testing.T) {
+	musttime.SetClock(func() time.Time { return base })
+	require.Equal(t, base, musttime.Now())
+
+	musttime.SetClock(nil)
+	musttime.WithinDuration(musttime.Now(), time.Now(), time.Minute)
+	musttime.InPast(base)
+	musttime.InFuture(time.Now().Add(time.Hour))
+}
+
+// TestDurationPositive tests positive duration assertion
+// Validates DurationPositive passes with positive durations and panics with zero or negative ones
+//
+// TestDurationPositive 测试正数时长断言
+// 验证 DurationPositive 在正数时长时通过，在零或负数时长时 panic
+func TestDurationPositive(t *testing.T) {
+	musttime.DurationPositive(time.Nanosecond)
+
+	require.Panics(t, func() {
+		musttime.DurationPositive(0)
+	})
+
+	require.Panics(t, func() {
+		musttime.DurationPositive(-time.Second)
+	})
+}
+
+// TestDurationBetween tests duration range assertion
+// Validates DurationBetween passes inside the inclusive range and panics outside it
+//
+// TestDurationBetween 测试时长范围断言
+// 验证 DurationBetween 在闭区间内通过，在区间外 panic
+func TestDurationBetween(t *testing.T) {
+	musttime.DurationBetween(time.Second, time.Second, time.Minute)
+	musttime.DurationBetween(time.Minute, time.Second, time.Minute)
+
+	require.Panics(t, func() {
+		musttime.DurationBetween(time.Millisecond, time.Second, time.Minute)
+	})
+
+	require.Panics(t, func() {
+		musttime.DurationBetween(time.Hour, time.Second, time.Minute)
+	})
+}
+
+// TestTimeFieldFormat tests the logged time format
+// Validates failures log times in RFC3339Nano keeping the nanoseconds and offset
+//
+// TestTimeFieldFormat 测试日志中的时间格式
+// 验证失败时以 RFC3339Nano 格式记录时间，保留纳秒和时区偏移
+func TestTimeFieldFormat(t *testing.T) {
+	logs := tests.ObserveLogs(t)
+
+	zone := time.FixedZone("UTC+8", 8*60*60)
+	require.Panics(t, func() {
+		musttime.Before(base.In(zone), base)
+	})
+
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	require.Equal(t, "2024-05-06T15:08:09.123456789+08:00", fields["a"])
+	require.Equal(t, "2024-05-06T07:08:09.123456789Z", fields["b"])
+}
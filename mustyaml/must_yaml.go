@@ -0,0 +1,152 @@
+// Package mustyaml provides YAML decoding, encoding and semantic equality assertions with panic-on-failure semantics
+// Implements must-style decoding, strict decoding rejecting unknown keys, and encoding through gopkg.in/yaml.v3
+// Supports semantic document equality with a path-based diff like "$.servers[0].port"
+// Integrates with zap structured logging, logging the line/column of decode errors when assertions are not met
+//
+// mustyaml 提供 YAML 解码、编码和语义相等断言，带 panic-on-failure 语义
+// 通过 gopkg.in/yaml.v3 实现 must 风格的解码、拒绝未知键的严格解码以及编码
+// 支持带路径差异的文档语义相等比较，路径形如 "$.servers[0].port"
+// 与 zap 结构化日志集成，当断言不满足时记录解码错误的行/列
+package mustyaml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// Marshal encodes v into YAML. Panics if encoding fails.
+// Marshal 将 v 编码为 YAML。如果编码失败则触发 panic。
+func Marshal(v any) []byte {
+	data, err := marshal(v)
+	if err != nil {
+		zaplog.ZAPS.Skip1.LOG.Panic("YAML MARSHAL FAILED(SHOULD BE MARSHALLED)", zap.String("type", fmt.Sprintf("%T", v)), zap.Error(err))
+	}
+	return data
+}
+
+// marshal encodes v into YAML, converting the panics yaml.v3 raises on unsupported values (like channels and funcs) into errors
+// marshal 将 v 编码为 YAML，并将 yaml.v3 在遇到不支持的值（例如 chan 和 func）时触发的 panic 转换为错误
+func marshal(v any) (data []byte, err error) {
+	defer func() {
+		if reason := recover(); reason != nil {
+			err = fmt.Errorf("yaml: %v", reason)
+		}
+	}()
+	return yaml.Marshal(v)
+}
+
+// Unmarshal decodes the first YAML document into a value of type T. Panics if decoding fails, logging the line/column.
+// Unmarshal 将第一个 YAML 文档解码为 T 类型的值。如果解码失败则触发 panic，并记录行/列。
+func Unmarshal[T any](data []byte) T {
+	var res T
+	if err := decode(data, &res, false); err != nil {
+		line, column := errorPosition(data, err)
+		zaplog.ZAPS.Skip1.LOG.Panic("YAML UNMARSHAL FAILED(SHOULD BE UNMARSHALLED)", zap.String("type", utils.TypeName[T]()), positionField("line", line), positionField("column", column), errorField(err))
+	}
+	return res
+}
+
+// UnmarshalStrict decodes the YAML document into a value of type T, rejecting unknown keys and extra documents.
+// Panics if decoding fails, logging the line/column.
+//
+// UnmarshalStrict 将 YAML 文档解码为 T 类型的值，拒绝未知键和多余的文档。
+// 如果解码失败则触发 panic，并记录行/列。
+func UnmarshalStrict[T any](data []byte) T {
+	var res T
+	if err := decode(data, &res, true); err != nil {
+		line, column := errorPosition(data, err)
+		zaplog.ZAPS.Skip1.LOG.Panic("YAML UNMARSHAL FAILED(SHOULD BE UNMARSHALLED)", zap.String("type", utils.TypeName[T]()), positionField("line", line), positionField("column", column), errorField(err))
+	}
+	return res
+}
+
+// decode decodes the first document into v, with strict rejecting unknown keys and extra documents
+// An empty input leaves v unchanged, the same as yaml.Unmarshal
+//
+// decode 将第一个文档解码到 v，strict 模式下拒绝未知键和多余的文档
+// 空输入保持 v 不变，与 yaml.Unmarshal 相同
+func decode(data []byte, v any, strict bool) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(strict)
+	if err := decoder.Decode(v); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+	if strict {
+		var extra yaml.Node
+		if err := decoder.Decode(&extra); !errors.Is(err, io.EOF) {
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("yaml: line %d: unexpected extra document", extra.Line)
+		}
+	}
+	return nil
+}
+
+// lineRegexp matches the "line N" position in yaml.v3 error messages
+// lineRegexp 匹配 yaml.v3 错误信息中的 "line N" 位置
+var lineRegexp = regexp.MustCompile(`line (\d+)`)
+
+// errorPosition locates the line of the first error in the message, and the column of the first node starting on that line
+// Returns 0 for the parts that cannot be located
+//
+// errorPosition 从错误信息中定位第一个错误的行，以及该行第一个节点的列
+// 无法定位的部分返回 0
+func errorPosition(data []byte, err error) (int, int) {
+	match := lineRegexp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0, 0
+	}
+	line, _ := strconv.Atoi(match[1])
+	var root yaml.Node
+	if yaml.Unmarshal(data, &root) != nil {
+		return line, 0
+	}
+	return line, firstColumn(&root, line)
+}
+
+// firstColumn returns the column of the first node starting on the line, 0 if there is none
+// firstColumn 返回该行第一个节点的列，如果没有则返回 0
+func firstColumn(node *yaml.Node, line int) int {
+	column := 0
+	if node.Line == line && node.Kind != yaml.DocumentNode {
+		column = node.Column
+	}
+	for _, child := range node.Content {
+		if sub := firstColumn(child, line); sub > 0 && (column == 0 || sub < column) {
+			column = sub
+		}
+	}
+	return column
+}
+
+// positionField logs the position, skipping it when unknown
+// positionField 记录位置，未知时跳过
+func positionField(key string, v int) zap.Field {
+	if v <= 0 {
+		return zap.Skip()
+	}
+	return zap.Int(key, v)
+}
+
+// errorField logs each message of a yaml.TypeError as a list, else logs the error
+// errorField 将 yaml.TypeError 的每条信息记录为列表，否则记录错误
+func errorField(err error) zap.Field {
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		return zap.Strings("errors", typeErr.Errors)
+	}
+	return zap.Error(err)
+}
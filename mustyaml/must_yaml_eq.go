@@ -0,0 +1,66 @@
+package mustyaml
+
+import (
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/yyle88/must/internal/utils"
+	"github.com/yyle88/zaplog"
+	"go.uber.org/zap"
+)
+
+// YAMLEq expects the first documents of a and b to be semantically equal, ignoring key order, formatting and comments.
+// Panics if either cannot be decoded, logging the line/column, or if they differ, logging each difference with its path like "$.servers[0].port".
+//
+// YAMLEq 期望 a 和 b 的第一个文档语义相等，忽略键的顺序、格式和注释。
+// 如果任一无法解码则触发 panic 并记录行/列；如果两者不同则触发 panic，并记录每处差异及其路径，例如 "$.servers[0].port"。
+func YAMLEq(a, b []byte) {
+	var va, vb any
+	if err := decode(a, &va, false); err != nil {
+		line, column := errorPosition(a, err)
+		zaplog.ZAPS.Skip1.LOG.Panic("YAML NOT VALID(SHOULD BE VALID)", zap.String("side", "a"), positionField("line", line), positionField("column", column), errorField(err))
+	}
+	if err := decode(b, &vb, false); err != nil {
+		line, column := errorPosition(b, err)
+		zaplog.ZAPS.Skip1.LOG.Panic("YAML NOT VALID(SHOULD BE VALID)", zap.String("side", "b"), positionField("line", line), positionField("column", column), errorField(err))
+	}
+	if diffs := utils.DocumentDiff(va, vb, sameScalar); len(diffs) > 0 {
+		zaplog.ZAPS.Skip1.LOG.Panic("YAML NOT EQUAL(SHOULD BE EQUAL)", utils.DiffFields(diffs)...)
+	}
+}
+
+// sameScalar compares the scalars, comparing numbers by value so 1 and 1.0 match, and times by instant
+// sameScalar 比较标量，按数值比较数字，使 1 和 1.0 相等，并按时刻比较时间
+func sameScalar(a, b any) bool {
+	if ta, ok := a.(time.Time); ok {
+		tb, ok := b.(time.Time)
+		return ok && ta.Equal(tb)
+	}
+	if a == b {
+		return true
+	}
+	fa, okA := toBigFloat(a)
+	fb, okB := toBigFloat(b)
+	return okA && okB && fa.Cmp(fb) == 0
+}
+
+// toBigFloat converts the numbers yaml.v3 produces into big.Float, NaN is not converted
+// toBigFloat 将 yaml.v3 生成的数字转换为 big.Float，NaN 不转换
+func toBigFloat(v any) (*big.Float, bool) {
+	switch num := v.(type) {
+	case int:
+		return new(big.Float).SetInt64(int64(num)), true
+	case int64:
+		return new(big.Float).SetInt64(num), true
+	case uint64:
+		return new(big.Float).SetUint64(num), true
+	case float64:
+		if math.IsNaN(num) {
+			return nil, false
+		}
+		return new(big.Float).SetFloat64(num), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,73 @@
+package mustyaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/internal/utils"
+)
+
+func TestErrorPosition(t *testing.T) {
+	type Server struct {
+		Host string `yaml:"host"`
+		Port int    `yaml:"port"`
+	}
+
+	data := []byte("servers:\n  - host: a.local\n    prot: 8080\n")
+	var res struct {
+		Servers []Server `yaml:"servers"`
+	}
+	err := decode(data, &res, true)
+	require.Error(t, err)
+	line, column := errorPosition(data, err)
+	require.Equal(t, 3, line)
+	require.Equal(t, 5, column)
+
+	data = []byte("host: a.local\nport: x\n  bad: 1\n")
+	err = decode(data, &Server{}, false)
+	require.Error(t, err)
+	line, column = errorPosition(data, err)
+	require.Equal(t, 3, line)
+	require.Equal(t, 0, column) // cannot parse the nodes of malformed data
+
+	line, column = errorPosition(data, errNoLine{})
+	require.Equal(t, 0, line)
+	require.Equal(t, 0, column)
+}
+
+type errNoLine struct{}
+
+func (errNoLine) Error() string { return "yaml: something went wrong" }
+
+func TestYAMLDiff(t *testing.T) {
+	var va, vb any
+	require.NoError(t, decode([]byte("a: 1\nb: {c: [1, 2, 3]}\nd: x\n5: five\n"), &va, false))
+	require.NoError(t, decode([]byte("a: 1.0\nb: {c: [1, 5]}\ne: null\n5: FIVE\n"), &vb, false))
+
+	require.Equal(t, []string{
+		`$.b.c: length 3 != 2`,
+		`$.b.c[1]: 2 != 5`,
+		`$.b.c[2]: only in a: 3`,
+		`$.d: only in a: "x"`,
+		`$.e: only in b: null`,
+		`$[5]: "five" != "FIVE"`,
+	}, utils.DocumentDiff(va, vb, sameScalar))
+}
+
+func TestYAMLDiffKeyTypes(t *testing.T) {
+	var va, vb any
+	require.NoError(t, decode([]byte("1: x\n"), &va, false))
+	require.NoError(t, decode([]byte("\"1\": x\n"), &vb, false))
+
+	require.Equal(t, []string{
+		`$["1"]: only in b: "x"`,
+		`$[1]: only in a: "x"`,
+	}, utils.DocumentDiff(va, vb, sameScalar))
+
+	require.NoError(t, decode([]byte("1: x\na: y\n"), &va, false))
+	require.NoError(t, decode([]byte("\"1\": x\na: y\n"), &vb, false))
+	require.Equal(t, []string{
+		`$["1"]: only in b: "x"`,
+		`$[1]: only in a: "x"`,
+	}, utils.DocumentDiff(va, vb, sameScalar))
+}
@@ -0,0 +1,124 @@
+// Package mustyaml_test provides comprehensive testing of mustyaml assertion package
+// Tests include encoding, decoding, strict decoding and semantic document equality
+// Checks each assertion functions with both success and failure cases
+//
+// mustyaml_test 为 mustyaml 断言包提供全面的测试
+// 测试涵盖编码、解码、严格解码和文档语义相等
+// 使用成功和失败案例验证所有断言函数
+package mustyaml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/must/mustyaml"
+)
+
+type Server struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+}
+
+type Config struct {
+	Name    string   `yaml:"name"`
+	Servers []Server `yaml:"servers"`
+}
+
+const configYAML = `
+name: app
+servers:
+  - host: a.local
+    port: 8080
+  - host: b.local
+    port: 9090
+`
+
+// TestMarshal tests must-style YAML encoding
+// Validates Marshal returns the YAML text and panics with unsupported values
+//
+// TestMarshal 测试 must 风格的 YAML 编码
+// 验证 Marshal 返回 YAML 文本，在值不受支持时 panic
+func TestMarshal(t *testing.T) {
+	require.Equal(t, "host: a.local\nport: 8080\n", string(mustyaml.Marshal(Server{Host: "a.local", Port: 8080})))
+
+	require.Panics(t, func() {
+		mustyaml.Marshal(make(chan int))
+	})
+
+	require.Panics(t, func() {
+		mustyaml.Marshal(map[string]any{"f": func() {}})
+	})
+}
+
+// TestUnmarshal tests must-style YAML decoding
+// Validates Unmarshal returns the typed value, ignores unknown keys, and panics with malformed or mistyped data
+//
+// TestUnmarshal 测试 must 风格的 YAML 解码
+// 验证 Unmarshal 返回指定类型的值，忽略未知键，在数据格式错误或类型不符时 panic
+func TestUnmarshal(t *testing.T) {
+	config := mustyaml.Unmarshal[Config]([]byte(configYAML))
+	require.Equal(t, Config{Name: "app", Servers: []Server{{Host: "a.local", Port: 8080}, {Host: "b.local", Port: 9090}}}, config)
+
+	require.Equal(t, Server{Host: "a.local"}, mustyaml.Unmarshal[Server]([]byte("host: a.local\nextra: true\n")))
+	require.Equal(t, Server{}, mustyaml.Unmarshal[Server](nil))
+
+	require.Panics(t, func() {
+		mustyaml.Unmarshal[Config]([]byte("name: app\nservers: [a\n"))
+	})
+
+	require.Panics(t, func() {
+		mustyaml.Unmarshal[Server]([]byte("host: a.local\nport: abc\n"))
+	})
+}
+
+// TestUnmarshalStrict tests strict YAML decoding
+// Validates UnmarshalStrict returns the typed value and panics with unknown keys or extra documents
+//
+// TestUnmarshalStrict 测试严格的 YAML 解码
+// 验证 UnmarshalStrict 返回指定类型的值，在出现未知键或多余文档时 panic
+func TestUnmarshalStrict(t *testing.T) {
+	require.Equal(t, Server{Host: "a.local", Port: 8080}, mustyaml.UnmarshalStrict[Server]([]byte("host: a.local\nport: 8080\n")))
+
+	require.Panics(t, func() {
+		mustyaml.UnmarshalStrict[Config]([]byte("name: app\nservers:\n  - host: a.local\n    prot: 8080\n"))
+	})
+
+	require.Panics(t, func() {
+		mustyaml.UnmarshalStrict[Server]([]byte("host: a.local\n---\nhost: b.local\n"))
+	})
+
+	require.Panics(t, func() {
+		mustyaml.UnmarshalStrict[Server]([]byte("host: a.local\nport: abc\n"))
+	})
+}
+
+// TestYAMLEq tests semantic YAML equality assertion
+// Validates YAMLEq ignores key order, formatting and comments, and panics when documents differ (including key types) or are malformed
+//
+// TestYAMLEq 测试 YAML 语义相等断言
+// 验证 YAMLEq 忽略键顺序、格式和注释，在文档不同（包括键的类型）或格式错误时 panic
+func TestYAMLEq(t *testing.T) {
+	mustyaml.YAMLEq([]byte(configYAML), []byte(`
+# same config in flow style
+servers: [{port: 8080, host: a.local}, {host: b.local, port: 9090.0}]
+name: "app"
+`))
+	mustyaml.YAMLEq([]byte("1: one\ntrue: yes"), []byte("true: yes\n1: one"))
+	mustyaml.YAMLEq([]byte("at: 2024-01-02T03:04:05Z"), []byte("at: 2024-01-02T11:04:05+08:00"))
+
+	require.Panics(t, func() {
+		mustyaml.YAMLEq([]byte(configYAML), []byte("name: app\nservers:\n  - host: a.local\n    port: 8081\n"))
+	})
+
+	require.Panics(t, func() {
+		mustyaml.YAMLEq([]byte("a: 1"), []byte("a: '1'"))
+	})
+
+	require.Panics(t, func() {
+		mustyaml.YAMLEq([]byte("1: one"), []byte("'1': one"))
+	})
+
+	require.Panics(t, func() {
+		mustyaml.YAMLEq([]byte("a: [1"), []byte("a: [1]"))
+	})
+}